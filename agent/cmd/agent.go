@@ -34,11 +34,17 @@ func serve(_ *cli.Context) error {
 }
 
 func getHealth(_ *cli.Context) error {
-	healthy, err := health.GetHealthy()
+	status, err := health.GetStatus()
 	if err != nil {
 		log.Error().Err(err).Send()
 	}
 
+	healthy := status != nil && status.Connected
+	if !healthy && status != nil && status.RetryAttempt > 0 {
+		log.Info().Int("attempt", status.RetryAttempt).Time("nextRetry", status.NextRetry).
+			Str("lastError", status.LastError).Msg("Reconnecting to the backend")
+	}
+
 	if healthy {
 		os.Exit(0)
 		return nil
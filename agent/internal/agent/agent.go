@@ -18,13 +18,31 @@ func Serve(cfg *config.Configuration) {
 
 	grpcParams := grpc.TokenToConnectionParams(cfg.JwtToken)
 	grpcContext := grpc.WithGRPCConfig(context.Background(), cfg)
+	startCrashLoopDetection(grpcContext, cfg)
 	grpc.Init(grpcContext, grpcParams, cfg, &grpc.WorkerFunctions{
-		Close:            grpcClose,
-		Watch:            WatchContainers,
-		ContaierDelete:   DeleteContainer,
-		ContainerCommand: ContainerCommand,
-		ContainerLog:     ContainerLog,
-		ContainerInspect: ContainerInspect,
+		Close:              grpcClose,
+		Watch:              WatchContainers,
+		ContaierDelete:     DeleteContainer,
+		ContainerCommand:   ContainerCommand,
+		StackCommand:       StackCommand,
+		ContainerLog:       ContainerLog,
+		ContainerLogExport: ContainerLogExport,
+		ContainerInspect:   ContainerInspect,
+		ContainerExec:      ContainerExec,
+		ContainerStats:     ContainerStats,
+		ContainerEvents:    ContainerEvents,
+		NodeInfo:           NodeInfo,
+		ImageList:          ImageList,
+		ImageInspect:       ImageInspect,
+		ImageDelete:        ImageDelete,
+		ImagePull:          ImagePull,
+		VolumeList:         VolumeList,
+		VolumeInspect:      VolumeInspect,
+		VolumeDelete:       VolumeDelete,
+		NetworkList:        NetworkList,
+		NetworkInspect:     NetworkInspect,
+		NetworkDelete:      NetworkDelete,
+		SystemPrune:        SystemPrune,
 	})
 }
 
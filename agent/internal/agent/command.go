@@ -2,16 +2,29 @@
 
 import (
 	"context"
-
-	"github.com/rs/zerolog/log"
+	"fmt"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
 	"github.com/dyrector-io/darklens/agent/internal/docker"
 	"github.com/dyrector-io/darklens/protobuf/go/agent"
 )
 
+func stopOptions(timeout *int32, signal string) container.StopOptions {
+	options := container.StopOptions{
+		Signal: signal,
+	}
+
+	if timeout != nil {
+		seconds := int(*timeout)
+		options.Timeout = &seconds
+	}
+
+	return options
+}
+
 func ContainerCommand(ctx context.Context, command *agent.ContainerCommandRequest) error {
 	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 	if err != nil {
@@ -27,14 +40,25 @@ func ContainerCommand(ctx context.Context, command *agent.ContainerCommandReques
 		return err
 	}
 
+	if cont == nil {
+		return fmt.Errorf("%w: %s", docker.ErrContainerNotFound, name)
+	}
+
 	if operation == agent.ContainerOperation_START_CONTAINER {
 		err = cli.ContainerStart(ctx, cont.ID, types.ContainerStartOptions{})
 	} else if operation == agent.ContainerOperation_STOP_CONTAINER {
-		err = cli.ContainerStop(ctx, cont.ID, container.StopOptions{})
+		err = cli.ContainerStop(ctx, cont.ID, stopOptions(command.Timeout, command.GetSignal()))
 	} else if operation == agent.ContainerOperation_RESTART_CONTAINER {
-		err = cli.ContainerRestart(ctx, cont.ID, container.StopOptions{})
+		err = cli.ContainerRestart(ctx, cont.ID, stopOptions(command.Timeout, command.GetSignal()))
+	} else if operation == agent.ContainerOperation_PAUSE_CONTAINER {
+		err = cli.ContainerPause(ctx, cont.ID)
+	} else if operation == agent.ContainerOperation_UNPAUSE_CONTAINER {
+		err = cli.ContainerUnpause(ctx, cont.ID)
+	} else if operation == agent.ContainerOperation_KILL_CONTAINER {
+		// the runtime sends SIGKILL when the signal is empty
+		err = cli.ContainerKill(ctx, cont.ID, command.GetSignal())
 	} else {
-		log.Error().Str("operation", operation.String()).Str("name", name).Msg("Unknown operation")
+		err = errdefs.InvalidParameter(fmt.Errorf("unknown container operation: %s", operation))
 	}
 
 	return err
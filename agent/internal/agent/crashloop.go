@@ -0,0 +1,172 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/dyrector-io/darklens/agent/internal/config"
+	"github.com/dyrector-io/darklens/protobuf/go/agent"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Flags the containers started at least `restarts` times within `window`, including restart policy restarts.
+// One detector runs for the agent with its own events subscription, so the start history outlives the watch
+// streams. Watchers record the starts they receive as well, a start is counted once by its time, so marking
+// a state update does not depend on which subscription gets the event first.
+type crashLoopDetector struct {
+	restarts int
+	window   time.Duration
+
+	mutex  sync.Mutex
+	starts map[string][]time.Time
+}
+
+// nil when the detection is disabled
+var crashLoops *crashLoopDetector
+
+// Returns nil when the detection is disabled
+func newCrashLoopDetector(cfg *config.Configuration) *crashLoopDetector {
+	if cfg.CrashLoopRestarts <= 0 || cfg.CrashLoopWindow <= 0 {
+		return nil
+	}
+
+	return &crashLoopDetector{
+		restarts: cfg.CrashLoopRestarts,
+		window:   cfg.CrashLoopWindow,
+		starts:   map[string][]time.Time{},
+	}
+}
+
+// Must be called before the gRPC loop starts, the detector is read without synchronization
+func startCrashLoopDetection(ctx context.Context, cfg *config.Configuration) {
+	crashLoops = newCrashLoopDetector(cfg)
+	if crashLoops == nil {
+		return
+	}
+
+	go crashLoops.run(ctx)
+}
+
+func (detector *crashLoopDetector) run(ctx context.Context) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create the client for crash loop detection")
+		return
+	}
+
+	since := time.Now()
+	reconnectDelay := watchReconnectMinDelay
+
+	for {
+		eventsCtx, cancelEvents := context.WithCancel(ctx)
+		chanMessages, chanErrors := cli.Events(eventsCtx, types.EventsOptions{
+			// replayed starts after a reconnect are only counted once
+			Since: dockerTimestamp(timestamppb.New(since)),
+			Filters: filters.NewArgs(
+				filters.Arg("type", string(events.ContainerEventType)),
+				filters.Arg("event", "start"),
+				filters.Arg("event", "destroy"),
+			),
+		})
+
+		connected := true
+		for connected {
+			select {
+			case <-ctx.Done():
+				cancelEvents()
+				return
+			case eventError := <-chanErrors:
+				if ctx.Err() != nil {
+					cancelEvents()
+					return
+				}
+
+				log.Warn().Err(eventError).Msg("Crash loop detection events disconnected, reconnecting")
+				connected = false
+			case eventMessage := <-chanMessages:
+				reconnectDelay = watchReconnectMinDelay
+				since = time.Unix(0, eventMessage.TimeNano)
+
+				detector.record(&eventMessage)
+			}
+		}
+
+		cancelEvents()
+
+		var ok bool
+		reconnectDelay, ok = waitReconnect(ctx, reconnectDelay)
+		if !ok {
+			return
+		}
+	}
+}
+
+// Records a start and returns the number of starts within the window, and whether the start is new.
+// Must be called with the mutex held.
+func (detector *crashLoopDetector) recordStart(containerID string, at time.Time) (int, bool) {
+	starts := detector.starts[containerID]
+
+	for _, it := range starts {
+		if it.Equal(at) {
+			return len(starts), false
+		}
+	}
+
+	windowStart := at.Add(-detector.window)
+	kept := 0
+	for kept < len(starts) && !starts[kept].After(windowStart) {
+		kept++
+	}
+
+	starts = append(starts[kept:], at)
+	detector.starts[containerID] = starts
+
+	return len(starts), true
+}
+
+// Returns the number of starts within the window after a start event, zero for other events
+func (detector *crashLoopDetector) record(event *events.Message) int {
+	if event.Type != events.ContainerEventType {
+		return 0
+	}
+
+	detector.mutex.Lock()
+	defer detector.mutex.Unlock()
+
+	switch event.Action {
+	case "destroy":
+		delete(detector.starts, event.Actor.ID)
+	case "start":
+		count, added := detector.recordStart(event.Actor.ID, time.Unix(0, event.TimeNano))
+		if added && count == detector.restarts {
+			log.Warn().Str("containerId", event.Actor.ID).Str("name", event.Actor.Attributes["name"]).
+				Int("starts", count).Stringer("window", detector.window).Msg("Container is crash looping")
+		}
+
+		return count
+	}
+
+	return 0
+}
+
+// Marks the state update of a crash looping container
+func (detector *crashLoopDetector) check(event *events.Message, item *agent.ContainerStateItem) {
+	if detector == nil {
+		return
+	}
+
+	count := detector.record(event)
+	if count < detector.restarts || item == nil {
+		return
+	}
+
+	item.Event = agent.ContainerStateEvent_CRASH_LOOP
+	item.Reason = fmt.Sprintf("started %d times within %s", count, detector.window)
+}
@@ -19,11 +19,11 @@ func DeleteContainer(ctx context.Context, req *agent.ContainerDeleteRequest) err
 
 	container, err := docker.GetContainerByName(ctx, cli, name)
 	if err != nil {
-		return fmt.Errorf("could not get container (%s) to delete: %s", name, err.Error())
+		return fmt.Errorf("could not get container (%s) to delete: %w", name, err)
 	}
 
 	if container == nil {
-		return nil
+		return fmt.Errorf("%w: %s", docker.ErrContainerNotFound, name)
 	}
 
 	return docker.DeleteContainer(ctx, container)
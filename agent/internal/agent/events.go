@@ -0,0 +1,112 @@
+package agent
+
+import (
+	"context"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/dyrector-io/darklens/agent/internal/grpc"
+	"github.com/dyrector-io/darklens/agent/internal/mapper"
+	"github.com/dyrector-io/darklens/protobuf/go/agent"
+)
+
+type DockerContainerEventsReader struct {
+	EventChannel chan grpc.ContainerEventsEvent
+	cancel       context.CancelFunc
+
+	grpc.ContainerEventsReader
+}
+
+func (dockerReader *DockerContainerEventsReader) Next() <-chan grpc.ContainerEventsEvent {
+	return dockerReader.EventChannel
+}
+
+func (dockerReader *DockerContainerEventsReader) Close() error {
+	dockerReader.cancel()
+	return nil
+}
+
+func containerEventsOptions(request *agent.ContainerEventsRequest) (types.EventsOptions, error) {
+	since := request.GetSince()
+	until := request.GetUntil()
+
+	err := validateTimeRange(since, until)
+	if err != nil {
+		return types.EventsOptions{}, err
+	}
+
+	eventFilters := filters.NewArgs()
+	for _, it := range request.Types {
+		eventFilters.Add("type", it)
+	}
+	if request.Container != nil {
+		eventFilters.Add("container", request.GetContainer())
+	}
+
+	return types.EventsOptions{
+		Since:   dockerTimestamp(since),
+		Until:   dockerTimestamp(until),
+		Filters: eventFilters,
+	}, nil
+}
+
+// The runtime closes the event stream with io.EOF after reaching until
+func streamDockerEvents(ctx context.Context,
+	chanMessages <-chan events.Message,
+	chanErrors <-chan error,
+	eventChannel chan grpc.ContainerEventsEvent,
+) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-chanErrors:
+			if ctx.Err() != nil {
+				err = ctx.Err()
+			}
+
+			select {
+			case <-ctx.Done():
+			case eventChannel <- grpc.ContainerEventsEvent{Error: err}:
+			}
+			return
+		case message := <-chanMessages:
+			event := grpc.ContainerEventsEvent{
+				Event: mapper.MapDockerEvent(&message),
+				Error: nil,
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case eventChannel <- event:
+			}
+		}
+	}
+}
+
+func ContainerEvents(ctx context.Context, request *agent.ContainerEventsRequest) (grpc.ContainerEventsReader, error) {
+	options, err := containerEventsOptions(request)
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	eventsCtx, cancel := context.WithCancel(ctx)
+
+	chanMessages, chanErrors := cli.Events(eventsCtx, options)
+	eventChannel := make(chan grpc.ContainerEventsEvent)
+
+	go streamDockerEvents(eventsCtx, chanMessages, chanErrors, eventChannel)
+
+	return &DockerContainerEventsReader{
+		EventChannel: eventChannel,
+		cancel:       cancel,
+	}, nil
+}
@@ -0,0 +1,177 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/dyrector-io/darklens/agent/internal/docker"
+	"github.com/dyrector-io/darklens/agent/internal/grpc"
+	"github.com/dyrector-io/darklens/protobuf/go/agent"
+)
+
+type DockerContainerExecSession struct {
+	EventChannel chan grpc.ContainerExecEvent
+	ExecID       string
+
+	client   client.APIClient
+	hijacked types.HijackedResponse
+
+	grpc.ContainerExecSession
+}
+
+// execOutputWriter forwards the output of an exec instance to the event channel
+type execOutputWriter struct {
+	ctx          context.Context
+	stream       agent.ContainerStream
+	eventChannel chan grpc.ContainerExecEvent
+}
+
+func (writer *execOutputWriter) Write(data []byte) (int, error) {
+	// the buffer is reused by the caller
+	event := grpc.ContainerExecEvent{
+		Stream: writer.stream,
+		Data:   append([]byte{}, data...),
+		Error:  nil,
+	}
+
+	select {
+	case <-writer.ctx.Done():
+		return 0, writer.ctx.Err()
+	case writer.eventChannel <- event:
+		return len(data), nil
+	}
+}
+
+func (session *DockerContainerExecSession) Next() <-chan grpc.ContainerExecEvent {
+	return session.EventChannel
+}
+
+func (session *DockerContainerExecSession) Write(data []byte) error {
+	_, err := session.hijacked.Conn.Write(data)
+	return err
+}
+
+func (session *DockerContainerExecSession) CloseWrite() error {
+	return session.hijacked.CloseWrite()
+}
+
+func (session *DockerContainerExecSession) Resize(ctx context.Context, width, height uint32) error {
+	return session.client.ContainerExecResize(ctx, session.ExecID, types.ResizeOptions{
+		Width:  uint(width),
+		Height: uint(height),
+	})
+}
+
+func (session *DockerContainerExecSession) ExitCode(ctx context.Context) (int32, error) {
+	inspect, err := session.client.ContainerExecInspect(ctx, session.ExecID)
+	if err != nil {
+		return 0, err
+	}
+
+	if inspect.Running {
+		return 0, fmt.Errorf("exec (%s) is still running", session.ExecID)
+	}
+
+	return int32(inspect.ExitCode), nil
+}
+
+func (session *DockerContainerExecSession) Close() error {
+	session.hijacked.Close()
+	return nil
+}
+
+func streamDockerExec(ctx context.Context, reader io.Reader, tty bool, eventChannel chan grpc.ContainerExecEvent) {
+	stdout := &execOutputWriter{
+		ctx:          ctx,
+		stream:       agent.ContainerStream_STDOUT,
+		eventChannel: eventChannel,
+	}
+
+	var err error
+	if tty {
+		// TTY output is not multiplexed, everything arrives on stdout
+		_, err = io.Copy(stdout, reader)
+	} else {
+		stderr := &execOutputWriter{
+			ctx:          ctx,
+			stream:       agent.ContainerStream_STDERR,
+			eventChannel: eventChannel,
+		}
+
+		_, err = stdcopy.StdCopy(stdout, stderr, reader)
+	}
+
+	if err == nil {
+		err = io.EOF
+	}
+
+	select {
+	case <-ctx.Done():
+	case eventChannel <- grpc.ContainerExecEvent{Error: err}:
+	}
+}
+
+func ContainerExec(ctx context.Context, request *agent.ContainerExecRequest) (grpc.ContainerExecSession, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	name := request.Name
+
+	cont, err := docker.GetContainerByName(ctx, cli, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if cont == nil {
+		return nil, fmt.Errorf("%w: %s", docker.ErrContainerNotFound, name)
+	}
+
+	if len(request.Command) < 1 {
+		return nil, fmt.Errorf("no command to execute in container: %s", name)
+	}
+
+	var consoleSize *[2]uint
+	if request.Tty && request.Size != nil {
+		consoleSize = &[2]uint{uint(request.Size.Height), uint(request.Size.Width)}
+	}
+
+	exec, err := cli.ContainerExecCreate(ctx, cont.ID, types.ExecConfig{
+		User:         request.GetUser(),
+		Tty:          request.Tty,
+		ConsoleSize:  consoleSize,
+		AttachStdin:  true,
+		AttachStderr: true,
+		AttachStdout: true,
+		Env:          request.Env,
+		WorkingDir:   request.GetWorkingDir(),
+		Cmd:          request.Command,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hijacked, err := cli.ContainerExecAttach(ctx, exec.ID, types.ExecStartCheck{
+		Tty:         request.Tty,
+		ConsoleSize: consoleSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	eventChannel := make(chan grpc.ContainerExecEvent)
+
+	go streamDockerExec(ctx, hijacked.Reader, request.Tty, eventChannel)
+
+	return &DockerContainerExecSession{
+		EventChannel: eventChannel,
+		ExecID:       exec.ID,
+		client:       cli,
+		hijacked:     hijacked,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/dyrector-io/darklens/agent/internal/docker"
+	"github.com/dyrector-io/darklens/agent/internal/grpc"
+	"github.com/dyrector-io/darklens/agent/internal/mapper"
+	"github.com/dyrector-io/darklens/protobuf/go/agent"
+)
+
+type DockerImagePullReader struct {
+	EventChannel chan grpc.ImagePullEvent
+	Reader       io.ReadCloser
+
+	grpc.ImagePullReader
+}
+
+func (dockerReader *DockerImagePullReader) Next() <-chan grpc.ImagePullEvent {
+	return dockerReader.EventChannel
+}
+
+func (dockerReader *DockerImagePullReader) Close() error {
+	return dockerReader.Reader.Close()
+}
+
+// The subset of the progress messages written by the runtime while pulling
+type dockerPullMessage struct {
+	Status   string `json:"status"`
+	ID       string `json:"id"`
+	Progress *struct {
+		Current int64 `json:"current"`
+		Total   int64 `json:"total"`
+	} `json:"progressDetail"`
+	Error string `json:"error"`
+}
+
+func streamDockerImagePull(ctx context.Context, reader io.Reader, eventChannel chan grpc.ImagePullEvent) {
+	decoder := json.NewDecoder(reader)
+
+	for {
+		message := dockerPullMessage{}
+
+		event := grpc.ImagePullEvent{}
+
+		err := decoder.Decode(&message)
+		if err != nil {
+			event.Error = err
+		} else {
+			event.Progress = &agent.ImagePullMessage{
+				Status:  message.Status,
+				LayerId: message.ID,
+			}
+
+			if message.Progress != nil {
+				event.Progress.Current = message.Progress.Current
+				event.Progress.Total = message.Progress.Total
+			}
+
+			event.Progress.Error = message.Error
+			event.Progress.ErrorCode = mapper.MapImagePullErrorCode(message.Error)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case eventChannel <- event:
+		}
+
+		if event.Error != nil {
+			return
+		}
+	}
+}
+
+func ImageList(ctx context.Context, request *agent.ImageListRequest) ([]*agent.ImageItem, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := cli.ImageList(ctx, types.ImageListOptions{
+		All: request.All,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := docker.GetAllContainers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.MapImageList(images, containers), nil
+}
+
+func ImageInspect(ctx context.Context, request *agent.ImageInspectRequest) (string, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return "", err
+	}
+
+	_, inspectionJSON, err := cli.ImageInspectWithRaw(ctx, request.Id)
+	if err != nil {
+		return "", err
+	}
+
+	return string(inspectionJSON), nil
+}
+
+func ImageDelete(ctx context.Context, request *agent.ImageDeleteRequest) error {
+	return docker.DeleteImage(ctx, request.Id, request.Force)
+}
+
+func ImagePull(ctx context.Context, request *agent.ImagePullRequest) (grpc.ImagePullReader, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := cli.ImagePull(ctx, request.Image, types.ImagePullOptions{
+		RegistryAuth: request.GetRegistryAuth(),
+		Platform:     request.GetPlatform(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	eventChannel := make(chan grpc.ImagePullEvent)
+
+	go streamDockerImagePull(ctx, reader, eventChannel)
+
+	return &DockerImagePullReader{
+		EventChannel: eventChannel,
+		Reader:       reader,
+	}, nil
+}
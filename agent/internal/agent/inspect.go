@@ -3,6 +3,7 @@
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/docker/docker/client"
 	"github.com/dyrector-io/darklens/agent/internal/docker"
@@ -22,6 +23,10 @@ func ContainerInspect(ctx context.Context, request *agent.ContainerInspectReques
 		return "", err
 	}
 
+	if cont == nil {
+		return "", fmt.Errorf("%w: %s", docker.ErrContainerNotFound, name)
+	}
+
 	containerInfo, err := cli.ContainerInspect(ctx, cont.ID)
 	if err != nil {
 		return "", err
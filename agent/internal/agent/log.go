@@ -7,13 +7,20 @@
 	"errors"
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/dyrector-io/darklens/protobuf/go/agent"
 	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/dyrector-io/darklens/agent/internal/docker"
 	"github.com/dyrector-io/darklens/agent/internal/grpc"
+	"github.com/dyrector-io/darklens/agent/internal/mapper"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type DockerContainerLogReader struct {
@@ -31,7 +38,40 @@ func (dockerReader *DockerContainerLogReader) Close() error {
 	return dockerReader.Reader.Close()
 }
 
-func streamDockerLog(reader io.ReadCloser, eventChannel chan grpc.ContainerLogEvent) {
+// The runtime prepends the RFC3339 timestamp followed by a space to each line
+func parseDockerLogLine(stream agent.ContainerStream, line string) grpc.ContainerLogEvent {
+	event := grpc.ContainerLogEvent{
+		Message: line,
+		Stream:  stream,
+		Error:   nil,
+	}
+
+	timestamp, content, found := strings.Cut(line, " ")
+	if !found {
+		return event
+	}
+
+	parsed, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return event
+	}
+
+	event.Timestamp = parsed
+	event.Message = content
+
+	return event
+}
+
+func mapDockerLogStream(header []byte) agent.ContainerStream {
+	switch stdcopy.StdType(header[0]) {
+	case stdcopy.Stderr, stdcopy.Systemerr:
+		return agent.ContainerStream_STDERR
+	default:
+		return agent.ContainerStream_STDOUT
+	}
+}
+
+func streamDockerLog(ctx context.Context, reader io.ReadCloser, eventChannel chan grpc.ContainerLogEvent) {
 	header := make([]byte, docker.DockerLogHeaderLength)
 
 	bufferSize := 2048
@@ -40,11 +80,11 @@ func streamDockerLog(reader io.ReadCloser, eventChannel chan grpc.ContainerLogEv
 	for {
 		_, err := reader.Read(header)
 		if err != nil {
-			eventChannel <- grpc.ContainerLogEvent{
+			sendLogEvent(ctx, eventChannel, grpc.ContainerLogEvent{
 				Message: "",
 				Error:   err,
-			}
-			break
+			})
+			return
 		}
 
 		payloadSize := int(binary.BigEndian.Uint32(header[4:]))
@@ -59,43 +99,214 @@ func streamDockerLog(reader io.ReadCloser, eventChannel chan grpc.ContainerLogEv
 			read += count
 
 			if err != nil {
-				eventChannel <- grpc.ContainerLogEvent{
+				if read > 0 && !sendLogEvent(ctx, eventChannel, parseDockerLogLine(mapDockerLogStream(header), string(buffer[0:read]))) {
+					return
+				}
+
+				sendLogEvent(ctx, eventChannel, grpc.ContainerLogEvent{
 					Message: "",
 					Error:   err,
-				}
-				break
+				})
+				return
 			}
 		}
 
-		if read > 0 {
-			eventChannel <- grpc.ContainerLogEvent{
-				Message: string(buffer[0:read]),
-				Error:   nil,
-			}
+		if read > 0 && !sendLogEvent(ctx, eventChannel, parseDockerLogLine(mapDockerLogStream(header), string(buffer[0:read]))) {
+			return
 		}
 	}
 }
 
-func streamDockerLogTTY(reader io.ReadCloser, eventChannel chan grpc.ContainerLogEvent) {
+func streamDockerLogTTY(ctx context.Context, reader io.ReadCloser, eventChannel chan grpc.ContainerLogEvent) {
 	buffer := bufio.NewReader(reader)
 
 	for {
 		message, err := buffer.ReadString('\n')
 		if err != nil {
-			eventChannel <- grpc.ContainerLogEvent{
+			sendLogEvent(ctx, eventChannel, grpc.ContainerLogEvent{
 				Message: "",
 				Error:   err,
-			}
-			break
+			})
+			return
 		}
 
-		eventChannel <- grpc.ContainerLogEvent{
-			Message: message,
-			Error:   nil,
+		// TTY output is not multiplexed, everything arrives on stdout
+		if !sendLogEvent(ctx, eventChannel, parseDockerLogLine(agent.ContainerStream_STDOUT, message)) {
+			return
 		}
 	}
 }
 
+// The runtime accepts RFC3339 or unix timestamps with fractional seconds
+func dockerTimestamp(timestamp *timestamppb.Timestamp) string {
+	if timestamp == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%d.%09d", timestamp.GetSeconds(), timestamp.GetNanos())
+}
+
+func validateTimeRange(since, until *timestamppb.Timestamp) error {
+	if since != nil && until != nil && until.AsTime().Before(since.AsTime()) {
+		return errdefs.InvalidParameter(fmt.Errorf("until (%s) is before since (%s)", until.AsTime(), since.AsTime()))
+	}
+
+	return nil
+}
+
+func containerLogsOptions(request *agent.ContainerLogRequest) (types.ContainerLogsOptions, error) {
+	since := request.GetSince()
+	until := request.GetUntil()
+
+	err := validateTimeRange(since, until)
+	if err != nil {
+		return types.ContainerLogsOptions{}, err
+	}
+
+	tail := fmt.Sprintf("%d", request.GetTail())
+	if request.GetTail() == 0 && (since != nil || until != nil) {
+		// a time range without a line limit means every line in the range
+		tail = "all"
+	}
+
+	stream := request.GetStream()
+
+	return types.ContainerLogsOptions{
+		ShowStdout: stream != agent.ContainerStream_STDERR,
+		ShowStderr: stream != agent.ContainerStream_STDOUT,
+		Since:      dockerTimestamp(since),
+		Until:      dockerTimestamp(until),
+		Follow:     request.GetStreaming(),
+		Tail:       tail,
+		Timestamps: true,
+	}, nil
+}
+
+func dockerContainerLog(ctx context.Context,
+	cli client.APIClient,
+	containerID string,
+	tty bool,
+	options types.ContainerLogsOptions,
+) (*DockerContainerLogReader, error) {
+	reader, err := cli.ContainerLogs(ctx, containerID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	eventChannel := make(chan grpc.ContainerLogEvent)
+
+	if tty {
+		go streamDockerLogTTY(ctx, reader, eventChannel)
+	} else {
+		go streamDockerLog(ctx, reader, eventChannel)
+	}
+
+	return &DockerContainerLogReader{
+		EventChannel: eventChannel,
+		Reader:       reader,
+	}, nil
+}
+
+func isAggregatedLog(request *agent.ContainerLogRequest) bool {
+	return len(request.Names) > 0 || len(request.Labels) > 0
+}
+
+// The containers of an aggregated log are listed by their names and labels
+func logContainers(ctx context.Context, cli client.APIClient, request *agent.ContainerLogRequest) ([]types.Container, error) {
+	if !isAggregatedLog(request) {
+		cont, err := docker.GetContainerByName(ctx, cli, request.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if cont == nil {
+			return nil, fmt.Errorf("%w: %s", docker.ErrContainerNotFound, request.Name)
+		}
+
+		return []types.Container{*cont}, nil
+	}
+
+	containers := []types.Container{}
+	found := map[string]bool{}
+
+	for _, name := range request.Names {
+		cont, err := docker.GetContainerByName(ctx, cli, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if cont == nil {
+			return nil, fmt.Errorf("%w: %s", docker.ErrContainerNotFound, name)
+		}
+
+		if !found[cont.ID] {
+			found[cont.ID] = true
+			containers = append(containers, *cont)
+		}
+	}
+
+	if len(request.Labels) > 0 {
+		args := filters.NewArgs()
+		for _, label := range request.Labels {
+			args.Add("label", label)
+		}
+
+		// stopped containers have nothing to follow
+		labeled, err := cli.ContainerList(ctx, types.ContainerListOptions{
+			All:     !request.GetStreaming(),
+			Filters: args,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, it := range labeled {
+			if !found[it.ID] {
+				found[it.ID] = true
+				containers = append(containers, it)
+			}
+		}
+	}
+
+	if len(containers) < 1 {
+		return nil, fmt.Errorf("%w: no container matches the labels", docker.ErrContainerNotFound)
+	}
+
+	return containers, nil
+}
+
+// The log of one container, continuation lines are joined before the logs are aggregated
+func containerLogSource(ctx context.Context,
+	cli client.APIClient,
+	containerID string,
+	request *agent.ContainerLogRequest,
+	options types.ContainerLogsOptions,
+	isContinuation logContinuationMatcher,
+) (grpc.ContainerLogReader, error) {
+	inspect, err := cli.ContainerInspect(ctx, containerID)
+	if err != nil {
+		return nil, err
+	}
+
+	tty := inspect.Config.Tty
+
+	var logReader grpc.ContainerLogReader
+	if cfg := logBufferConfig(ctx, request); cfg != nil {
+		logReader, err = bufferedContainerLog(ctx, cli, containerID, tty, request, options, cfg)
+	} else {
+		logReader, err = dockerContainerLog(ctx, cli, containerID, tty, options)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if isContinuation != nil {
+		logReader = newJoinedContainerLogReader(ctx, logReader, isContinuation, request.GetMultiline())
+	}
+
+	return logReader, nil
+}
+
 func ContainerLog(ctx context.Context, request *agent.ContainerLogRequest) (*grpc.ContainerLogContext, error) {
 	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 	if err != nil {
@@ -113,50 +324,63 @@ func ContainerLog(ctx context.Context, request *agent.ContainerLogRequest) (*grp
 		self = &types.Container{}
 	}
 
-	name := request.Name
-
-	cont, err := docker.GetContainerByName(ctx, cli, name)
+	options, err := containerLogsOptions(request)
 	if err != nil {
-		return nil, fmt.Errorf("container not found: %w", err)
+		return nil, err
 	}
 
-	containerID := cont.ID
-	enableEcho := containerID != self.ID
+	match, err := newLogLineMatcher(request.GetFilter())
+	if err != nil {
+		return nil, err
+	}
 
-	log.Trace().Str("name", name).Str("selfContainerId", self.ID).Msgf("Container log echo enabled: %t", enableEcho)
+	isContinuation, err := newLogContinuationMatcher(request.GetMultiline())
+	if err != nil {
+		return nil, err
+	}
 
-	inspect, err := cli.ContainerInspect(ctx, containerID)
+	containers, err := logContainers(ctx, cli, request)
 	if err != nil {
 		return nil, err
 	}
 
-	tty := inspect.Config.Tty
+	enableEcho := true
+	names := make([]string, 0, len(containers))
+	sources := make([]grpc.ContainerLogReader, 0, len(containers))
 
-	streaming := request.GetStreaming()
-	tail := fmt.Sprintf("%d", request.GetTail())
+	for i := range containers {
+		cont := &containers[i]
+		if cont.ID == self.ID {
+			enableEcho = false
+		}
 
-	eventChannel := make(chan grpc.ContainerLogEvent)
+		source, err := containerLogSource(ctx, cli, cont.ID, request, options, isContinuation)
+		if err != nil {
+			closeErr := closeLogReaders(sources)
+			if closeErr != nil {
+				log.Error().Err(closeErr).Msg("Failed to close container log readers")
+			}
 
-	reader, err := cli.ContainerLogs(ctx, containerID, types.ContainerLogsOptions{
-		ShowStderr: true,
-		ShowStdout: true,
-		Follow:     streaming,
-		Tail:       tail,
-		Timestamps: true,
-	})
-	if err != nil {
-		return nil, err
+			return nil, err
+		}
+
+		names = append(names, mapper.MapContainerName(cont))
+		sources = append(sources, source)
 	}
 
-	if tty {
-		go streamDockerLogTTY(reader, eventChannel)
-	} else {
-		go streamDockerLog(reader, eventChannel)
+	log.Trace().Strs("names", names).Str("selfContainerId", self.ID).Msgf("Container log echo enabled: %t", enableEcho)
+
+	logReader := sources[0]
+	if isAggregatedLog(request) {
+		logReader = newMergedContainerLogReader(ctx, names, sources)
 	}
 
-	logReader := &DockerContainerLogReader{
-		EventChannel: eventChannel,
-		Reader:       reader,
+	if match != nil {
+		logReader = newFilteredContainerLogReader(ctx, logReader, match, request.GetFilter())
+	}
+
+	if request.GetParse() {
+		logReader = newContainerLogStage(ctx, logReader, parseContainerLog)
 	}
 
 	logContext := &grpc.ContainerLogContext{
@@ -0,0 +1,86 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/dyrector-io/darklens/agent/internal/docker"
+	"github.com/dyrector-io/darklens/protobuf/go/agent"
+)
+
+// DockerLogExportReader reads the demultiplexed log, both streams are written in their original order
+type DockerLogExportReader struct {
+	pipe   *io.PipeReader
+	reader io.ReadCloser
+}
+
+func (exportReader *DockerLogExportReader) Read(data []byte) (int, error) {
+	return exportReader.pipe.Read(data)
+}
+
+func (exportReader *DockerLogExportReader) Close() error {
+	exportReader.pipe.Close()
+	return exportReader.reader.Close()
+}
+
+func ContainerLogExport(ctx context.Context, request *agent.ContainerLogExportRequest) (io.ReadCloser, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	name := request.Name
+
+	err = validateTimeRange(request.GetSince(), request.GetUntil())
+	if err != nil {
+		return nil, err
+	}
+
+	cont, err := docker.GetContainerByName(ctx, cli, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if cont == nil {
+		return nil, fmt.Errorf("%w: %s", docker.ErrContainerNotFound, name)
+	}
+
+	inspect, err := cli.ContainerInspect(ctx, cont.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := cli.ContainerLogs(ctx, cont.ID, types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Since:      dockerTimestamp(request.GetSince()),
+		Until:      dockerTimestamp(request.GetUntil()),
+		Follow:     false,
+		Tail:       "all",
+		Timestamps: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// TTY output is not multiplexed
+	if inspect.Config.Tty {
+		return reader, nil
+	}
+
+	pipeReader, pipeWriter := io.Pipe()
+
+	go func() {
+		_, err := stdcopy.StdCopy(pipeWriter, pipeWriter, reader)
+		pipeWriter.CloseWithError(err)
+	}()
+
+	return &DockerLogExportReader{
+		pipe:   pipeReader,
+		reader: reader,
+	}, nil
+}
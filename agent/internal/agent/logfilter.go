@@ -0,0 +1,126 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/docker/docker/errdefs"
+	"github.com/dyrector-io/darklens/agent/internal/grpc"
+	"github.com/dyrector-io/darklens/protobuf/go/agent"
+)
+
+// The context lines are held in memory, so their count is limited
+const maxLogContextLines = 1000
+
+type logLineMatcher func(line string) bool
+
+func newLogLineMatcher(filter *agent.ContainerLogFilter) (logLineMatcher, error) {
+	if filter.GetBefore() > maxLogContextLines || filter.GetAfter() > maxLogContextLines {
+		return nil, errdefs.InvalidParameter(fmt.Errorf("log filter context is limited to %d lines", maxLogContextLines))
+	}
+
+	if filter.GetExpression() == "" {
+		return nil, nil
+	}
+
+	var match logLineMatcher
+
+	if filter.Regex {
+		expression := filter.Expression
+		if filter.CaseInsensitive {
+			expression = "(?i)" + expression
+		}
+
+		pattern, err := regexp.Compile(expression)
+		if err != nil {
+			return nil, errdefs.InvalidParameter(fmt.Errorf("invalid log filter expression: %w", err))
+		}
+
+		match = pattern.MatchString
+	} else if filter.CaseInsensitive {
+		expression := strings.ToLower(filter.Expression)
+		match = func(line string) bool {
+			return strings.Contains(strings.ToLower(line), expression)
+		}
+	} else {
+		match = func(line string) bool {
+			return strings.Contains(line, filter.Expression)
+		}
+	}
+
+	invert := filter.Invert
+
+	// log lines keep their line break, which would defeat end anchors in the expression
+	return func(line string) bool {
+		return match(strings.TrimRight(line, "\r\n")) != invert
+	}, nil
+}
+
+func filterContainerLog(ctx context.Context,
+	source grpc.ContainerLogReader,
+	match logLineMatcher,
+	before, after uint32,
+	eventChannel chan grpc.ContainerLogEvent,
+) {
+	// the last non matching lines, sent when the next match arrives
+	preceding := []grpc.ContainerLogEvent{}
+	remainingAfter := uint32(0)
+
+	for {
+		var event grpc.ContainerLogEvent
+		select {
+		case <-ctx.Done():
+			return
+		case event = <-source.Next():
+		}
+
+		if event.Error != nil {
+			sendLogEvent(ctx, eventChannel, event)
+			return
+		}
+
+		if match(event.Message) {
+			for _, it := range preceding {
+				if !sendLogEvent(ctx, eventChannel, it) {
+					return
+				}
+			}
+			preceding = preceding[:0]
+
+			if !sendLogEvent(ctx, eventChannel, event) {
+				return
+			}
+
+			remainingAfter = after
+			continue
+		}
+
+		if remainingAfter > 0 {
+			if !sendLogEvent(ctx, eventChannel, event) {
+				return
+			}
+
+			remainingAfter--
+			continue
+		}
+
+		if before > 0 {
+			if len(preceding) == int(before) {
+				preceding = append(preceding[:0], preceding[1:]...)
+			}
+			preceding = append(preceding, event)
+		}
+	}
+}
+
+func newFilteredContainerLogReader(ctx context.Context,
+	source grpc.ContainerLogReader,
+	match logLineMatcher,
+	filter *agent.ContainerLogFilter,
+) *ContainerLogStageReader {
+	return newContainerLogStage(ctx, source, func(ctx context.Context, source grpc.ContainerLogReader, eventChannel chan grpc.ContainerLogEvent) {
+		filterContainerLog(ctx, source, match, filter.GetBefore(), filter.GetAfter(), eventChannel)
+	})
+}
@@ -0,0 +1,177 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/dyrector-io/darklens/agent/internal/grpc"
+	"github.com/rs/zerolog/log"
+)
+
+// Lines are held back for this long, so the earlier lines of slower containers can be sent before them
+const logMergeWindow = 200 * time.Millisecond
+
+type MergedContainerLogReader struct {
+	EventChannel chan grpc.ContainerLogEvent
+	Sources      []grpc.ContainerLogReader
+
+	grpc.ContainerLogReader
+}
+
+func (mergedReader *MergedContainerLogReader) Next() <-chan grpc.ContainerLogEvent {
+	return mergedReader.EventChannel
+}
+
+func (mergedReader *MergedContainerLogReader) Close() error {
+	return closeLogReaders(mergedReader.Sources)
+}
+
+type sourceLogEvent struct {
+	source int
+	event  grpc.ContainerLogEvent
+}
+
+type queuedLogEvent struct {
+	event    grpc.ContainerLogEvent
+	received time.Time
+}
+
+func closeLogReaders(readers []grpc.ContainerLogReader) error {
+	errs := []error{}
+	for _, it := range readers {
+		err := it.Close()
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func forwardLogEvents(ctx context.Context, source int, reader grpc.ContainerLogReader, events chan sourceLogEvent) {
+	for {
+		var event grpc.ContainerLogEvent
+		select {
+		case <-ctx.Done():
+			return
+		case event = <-reader.Next():
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case events <- sourceLogEvent{source: source, event: event}:
+		}
+
+		if event.Error != nil {
+			return
+		}
+	}
+}
+
+// Returns the source with the earliest line, and whether it can be sent or has to wait for the other sources
+func earliestLogEvent(queues [][]queuedLogEvent, done []bool, now time.Time) (int, bool) {
+	earliest := -1
+	complete := true
+
+	for i, queue := range queues {
+		if len(queue) < 1 {
+			if !done[i] {
+				complete = false
+			}
+			continue
+		}
+
+		if earliest < 0 || queue[0].event.Timestamp.Before(queues[earliest][0].event.Timestamp) {
+			earliest = i
+		}
+	}
+
+	if earliest < 0 {
+		return -1, false
+	}
+
+	return earliest, complete || now.Sub(queues[earliest][0].received) >= logMergeWindow
+}
+
+func mergeContainerLog(ctx context.Context, names []string, sources []grpc.ContainerLogReader, eventChannel chan grpc.ContainerLogEvent) {
+	events := make(chan sourceLogEvent)
+	for i, it := range sources {
+		go forwardLogEvents(ctx, i, it, events)
+	}
+
+	queues := make([][]queuedLogEvent, len(sources))
+	done := make([]bool, len(sources))
+	remaining := len(sources)
+
+	var lastErr error
+
+	waitTimer := time.NewTimer(logMergeWindow)
+	defer waitTimer.Stop()
+
+	for {
+		source, ready := earliestLogEvent(queues, done, time.Now())
+		for ready {
+			event := queues[source][0].event
+			event.Container = names[source]
+			queues[source] = queues[source][1:]
+
+			if !sendLogEvent(ctx, eventChannel, event) {
+				return
+			}
+
+			source, ready = earliestLogEvent(queues, done, time.Now())
+		}
+
+		if remaining < 1 && source < 0 {
+			break
+		}
+
+		if !waitTimer.Stop() {
+			select {
+			case <-waitTimer.C:
+			default:
+			}
+		}
+		if source >= 0 {
+			waitTimer.Reset(logMergeWindow - time.Since(queues[source][0].received))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-waitTimer.C:
+		case it := <-events:
+			if it.event.Error != nil {
+				if !errors.Is(it.event.Error, io.EOF) {
+					log.Warn().Err(it.event.Error).Str("name", names[it.source]).Msg("Aggregated container log reader error")
+				}
+
+				done[it.source] = true
+				remaining--
+				lastErr = it.event.Error
+				continue
+			}
+
+			queues[it.source] = append(queues[it.source], queuedLogEvent{
+				event:    it.event,
+				received: time.Now(),
+			})
+		}
+	}
+
+	sendLogEvent(ctx, eventChannel, grpc.ContainerLogEvent{Error: lastErr})
+}
+
+func newMergedContainerLogReader(ctx context.Context, names []string, sources []grpc.ContainerLogReader) *MergedContainerLogReader {
+	eventChannel := make(chan grpc.ContainerLogEvent)
+
+	go mergeContainerLog(ctx, names, sources, eventChannel)
+
+	return &MergedContainerLogReader{
+		EventChannel: eventChannel,
+		Sources:      sources,
+	}
+}
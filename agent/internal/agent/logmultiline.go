@@ -0,0 +1,129 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/errdefs"
+	"github.com/dyrector-io/darklens/agent/internal/grpc"
+	"github.com/dyrector-io/darklens/protobuf/go/agent"
+)
+
+const (
+	defaultMultilineFlushTimeout = 500 * time.Millisecond
+	// A runaway continuation pattern must not hold back the log forever
+	maxMultilineLines = 1000
+)
+
+type logContinuationMatcher func(line string) bool
+
+func isIndentedLogLine(line string) bool {
+	return strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")
+}
+
+func newLogContinuationMatcher(multiline *agent.ContainerLogMultiline) (logContinuationMatcher, error) {
+	if multiline == nil {
+		return nil, nil
+	}
+
+	if multiline.Continuation == "" {
+		return isIndentedLogLine, nil
+	}
+
+	pattern, err := regexp.Compile(multiline.Continuation)
+	if err != nil {
+		return nil, errdefs.InvalidParameter(fmt.Errorf("invalid log continuation expression: %w", err))
+	}
+
+	return func(line string) bool {
+		return pattern.MatchString(strings.TrimRight(line, "\r\n"))
+	}, nil
+}
+
+func joinContainerLog(ctx context.Context,
+	source grpc.ContainerLogReader,
+	isContinuation logContinuationMatcher,
+	flushTimeout time.Duration,
+	eventChannel chan grpc.ContainerLogEvent,
+) {
+	var pending *grpc.ContainerLogEvent
+	pendingLines := 0
+
+	flushTimer := time.NewTimer(flushTimeout)
+	flushTimer.Stop()
+
+	defer flushTimer.Stop()
+
+	sendPending := func() bool {
+		if pending == nil {
+			return true
+		}
+
+		event := *pending
+		pending = nil
+
+		return sendLogEvent(ctx, eventChannel, event)
+	}
+
+	for {
+		var event grpc.ContainerLogEvent
+		select {
+		case <-ctx.Done():
+			return
+		case <-flushTimer.C:
+			if !sendPending() {
+				return
+			}
+			continue
+		case event = <-source.Next():
+		}
+
+		if event.Error != nil {
+			if sendPending() {
+				sendLogEvent(ctx, eventChannel, event)
+			}
+			return
+		}
+
+		if pending != nil && pending.Stream == event.Stream && pendingLines < maxMultilineLines && isContinuation(event.Message) {
+			pending.Message += event.Message
+			// resuming continues after the last joined line
+			pending.Offset = event.Offset
+			pendingLines++
+		} else {
+			if !sendPending() {
+				return
+			}
+
+			pending = &event
+			pendingLines = 1
+		}
+
+		// the timer might have fired while the line was received
+		if !flushTimer.Stop() {
+			select {
+			case <-flushTimer.C:
+			default:
+			}
+		}
+		flushTimer.Reset(flushTimeout)
+	}
+}
+
+func newJoinedContainerLogReader(ctx context.Context,
+	source grpc.ContainerLogReader,
+	isContinuation logContinuationMatcher,
+	multiline *agent.ContainerLogMultiline,
+) *ContainerLogStageReader {
+	flushTimeout := time.Duration(multiline.GetFlushTimeout()) * time.Millisecond
+	if flushTimeout <= 0 {
+		flushTimeout = defaultMultilineFlushTimeout
+	}
+
+	return newContainerLogStage(ctx, source, func(ctx context.Context, source grpc.ContainerLogReader, eventChannel chan grpc.ContainerLogEvent) {
+		joinContainerLog(ctx, source, isContinuation, flushTimeout, eventChannel)
+	})
+}
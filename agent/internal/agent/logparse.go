@@ -0,0 +1,269 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dyrector-io/darklens/agent/internal/grpc"
+	"github.com/dyrector-io/darklens/protobuf/go/agent"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Keys used by the common loggers (zerolog, pino, logrus, bunyan), in order of precedence
+var (
+	logLevelKeys     = []string{"level", "lvl", "severity", "log.level", "levelname"}
+	logMessageKeys   = []string{"message", "msg"}
+	logTimestampKeys = []string{"time", "timestamp", "ts", "@timestamp"}
+)
+
+// Unix timestamps above this are in milliseconds (pino)
+const unixMilliThreshold = 1e12
+
+func parseLogLevel(level string) agent.LogLevel {
+	switch strings.ToLower(level) {
+	case "trace", "trc":
+		return agent.LogLevel_TRACE
+	case "debug", "dbg":
+		return agent.LogLevel_DEBUG
+	case "info", "inf", "information", "notice":
+		return agent.LogLevel_INFO
+	case "warn", "wrn", "warning":
+		return agent.LogLevel_WARN
+	case "error", "err":
+		return agent.LogLevel_ERROR
+	case "fatal", "ftl", "panic", "critical", "crit", "alert", "emerg":
+		return agent.LogLevel_FATAL
+	}
+
+	// pino and bunyan use numeric levels
+	numeric, err := strconv.Atoi(level)
+	if err != nil {
+		return agent.LogLevel_LOG_LEVEL_UNSPECIFIED
+	}
+
+	switch {
+	case numeric >= 60:
+		return agent.LogLevel_FATAL
+	case numeric >= 50:
+		return agent.LogLevel_ERROR
+	case numeric >= 40:
+		return agent.LogLevel_WARN
+	case numeric >= 30:
+		return agent.LogLevel_INFO
+	case numeric >= 20:
+		return agent.LogLevel_DEBUG
+	case numeric >= 10:
+		return agent.LogLevel_TRACE
+	default:
+		return agent.LogLevel_LOG_LEVEL_UNSPECIFIED
+	}
+}
+
+func parseLogTimestamp(value string) (time.Time, bool) {
+	parsed, err := time.Parse(time.RFC3339Nano, value)
+	if err == nil {
+		return parsed, true
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || number <= 0 {
+		return time.Time{}, false
+	}
+
+	if number >= unixMilliThreshold {
+		return time.UnixMilli(int64(number)), true
+	}
+
+	seconds, fraction := math.Modf(number)
+	return time.Unix(int64(seconds), int64(fraction*float64(time.Second))), true
+}
+
+func takeLogField(fields map[string]string, keys []string) (string, string, bool) {
+	for _, key := range keys {
+		value, ok := fields[key]
+		if ok {
+			delete(fields, key)
+			return key, value, true
+		}
+	}
+
+	return "", "", false
+}
+
+func newStructuredLog(format agent.LogFormat, fields map[string]string) *agent.StructuredLog {
+	structured := &agent.StructuredLog{
+		Format: format,
+		Fields: fields,
+	}
+
+	_, level, ok := takeLogField(fields, logLevelKeys)
+	if ok {
+		structured.Level = parseLogLevel(level)
+	}
+
+	_, message, ok := takeLogField(fields, logMessageKeys)
+	if ok {
+		structured.Message = message
+	}
+
+	key, timestamp, ok := takeLogField(fields, logTimestampKeys)
+	if ok {
+		parsed, valid := parseLogTimestamp(timestamp)
+		if valid {
+			structured.Timestamp = timestamppb.New(parsed)
+		} else {
+			// unknown formats are kept as a field
+			fields[key] = timestamp
+		}
+	}
+
+	return structured
+}
+
+func logFieldValue(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case json.Number:
+		return typed.String()
+	default:
+		encoded, err := json.Marshal(typed)
+		if err != nil {
+			return fmt.Sprint(typed)
+		}
+
+		return string(encoded)
+	}
+}
+
+func parseJSONLog(line string) *agent.StructuredLog {
+	decoder := json.NewDecoder(strings.NewReader(line))
+	decoder.UseNumber()
+
+	values := map[string]any{}
+
+	err := decoder.Decode(&values)
+	if err != nil {
+		return nil
+	}
+
+	fields := make(map[string]string, len(values))
+	for key, value := range values {
+		fields[key] = logFieldValue(value)
+	}
+
+	return newStructuredLog(agent.LogFormat_JSON, fields)
+}
+
+// Splits a key=value sequence, values may be quoted. Returns false when the line is not logfmt.
+func parseLogfmtFields(line string) (map[string]string, bool) {
+	fields := map[string]string{}
+
+	rest := line
+	for rest != "" {
+		separator := strings.IndexByte(rest, '=')
+		if separator < 1 {
+			return nil, false
+		}
+
+		key := rest[:separator]
+		if strings.ContainsAny(key, " \t\"") {
+			return nil, false
+		}
+
+		rest = rest[separator+1:]
+
+		var value string
+		if strings.HasPrefix(rest, "\"") {
+			end := 1
+			for end < len(rest) && rest[end] != '"' {
+				if rest[end] == '\\' {
+					end++
+				}
+				end++
+			}
+
+			if end >= len(rest) {
+				return nil, false
+			}
+
+			unquoted, err := strconv.Unquote(rest[:end+1])
+			if err != nil {
+				return nil, false
+			}
+
+			value = unquoted
+			rest = rest[end+1:]
+		} else {
+			end := strings.IndexAny(rest, " \t")
+			if end < 0 {
+				end = len(rest)
+			}
+
+			value = rest[:end]
+			rest = rest[end:]
+		}
+
+		fields[key] = value
+		rest = strings.TrimLeft(rest, " \t")
+	}
+
+	return fields, true
+}
+
+func parseLogfmtLog(line string) *agent.StructuredLog {
+	fields, ok := parseLogfmtFields(line)
+	if !ok {
+		return nil
+	}
+
+	// plain text can contain a few key=value pairs, require a level or a message
+	for _, keys := range [][]string{logLevelKeys, logMessageKeys} {
+		for _, key := range keys {
+			if _, found := fields[key]; found {
+				return newStructuredLog(agent.LogFormat_LOGFMT, fields)
+			}
+		}
+	}
+
+	return nil
+}
+
+func parseStructuredLog(message string) *agent.StructuredLog {
+	line := strings.TrimSpace(message)
+	if line == "" {
+		return nil
+	}
+
+	if strings.HasPrefix(line, "{") {
+		return parseJSONLog(line)
+	}
+
+	return parseLogfmtLog(line)
+}
+
+func parseContainerLog(ctx context.Context, source grpc.ContainerLogReader, eventChannel chan grpc.ContainerLogEvent) {
+	for {
+		var event grpc.ContainerLogEvent
+		select {
+		case <-ctx.Done():
+			return
+		case event = <-source.Next():
+		}
+
+		if event.Error == nil {
+			event.Structured = parseStructuredLog(event.Message)
+		}
+
+		if !sendLogEvent(ctx, eventChannel, event) || event.Error != nil {
+			return
+		}
+	}
+}
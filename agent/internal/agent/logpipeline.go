@@ -0,0 +1,45 @@
+package agent
+
+import (
+	"context"
+
+	"github.com/dyrector-io/darklens/agent/internal/grpc"
+)
+
+// A stage of the log pipeline reads the events of its source and writes the processed ones to the event channel
+type containerLogStage func(ctx context.Context, source grpc.ContainerLogReader, eventChannel chan grpc.ContainerLogEvent)
+
+type ContainerLogStageReader struct {
+	EventChannel chan grpc.ContainerLogEvent
+	Source       grpc.ContainerLogReader
+
+	grpc.ContainerLogReader
+}
+
+func (stageReader *ContainerLogStageReader) Next() <-chan grpc.ContainerLogEvent {
+	return stageReader.EventChannel
+}
+
+func (stageReader *ContainerLogStageReader) Close() error {
+	return stageReader.Source.Close()
+}
+
+func sendLogEvent(ctx context.Context, eventChannel chan grpc.ContainerLogEvent, event grpc.ContainerLogEvent) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case eventChannel <- event:
+		return true
+	}
+}
+
+func newContainerLogStage(ctx context.Context, source grpc.ContainerLogReader, stage containerLogStage) *ContainerLogStageReader {
+	eventChannel := make(chan grpc.ContainerLogEvent)
+
+	go stage(ctx, source, eventChannel)
+
+	return &ContainerLogStageReader{
+		EventChannel: eventChannel,
+		Source:       source,
+	}
+}
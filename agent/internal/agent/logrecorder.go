@@ -0,0 +1,425 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+	"github.com/dyrector-io/darklens/agent/internal/config"
+	"github.com/dyrector-io/darklens/agent/internal/docker"
+	"github.com/dyrector-io/darklens/agent/internal/grpc"
+	"github.com/dyrector-io/darklens/agent/internal/logbuffer"
+	"github.com/dyrector-io/darklens/protobuf/go/agent"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Followed containers are recorded independently of the gRPC stream, so the lines written
+// while the backend is disconnected can be sent after reconnecting. The recording stops after
+// being idle without readers. The buffer and cancel are set before started is closed, the other
+// fields are guarded by logRecordersMutex.
+type containerLogRecorder struct {
+	buffer *logbuffer.Buffer
+	cancel context.CancelFunc
+	idle   time.Duration
+
+	readers   int
+	idleTimer *time.Timer
+	stopping  bool
+	// closed when the recording is running, or after a failed start removed the recorder from logRecorders
+	started chan struct{}
+	// closed after the buffer is closed and the recorder is removed from logRecorders
+	done chan struct{}
+}
+
+var (
+	logRecorders        = map[string]*containerLogRecorder{}
+	logRecordersMutex   sync.Mutex
+	pruneLogBuffersOnce sync.Once
+)
+
+type BufferedContainerLogReader struct {
+	EventChannel chan grpc.ContainerLogEvent
+	Reader       *logbuffer.Reader
+	Recorder     *containerLogRecorder
+
+	releaseOnce sync.Once
+
+	grpc.ContainerLogReader
+}
+
+func (bufferedReader *BufferedContainerLogReader) Next() <-chan grpc.ContainerLogEvent {
+	return bufferedReader.EventChannel
+}
+
+func (bufferedReader *BufferedContainerLogReader) Close() error {
+	bufferedReader.releaseOnce.Do(bufferedReader.Recorder.release)
+	return bufferedReader.Reader.Close()
+}
+
+// Must be called with logRecordersMutex held
+func (recorder *containerLogRecorder) acquire() {
+	recorder.readers++
+
+	if recorder.idleTimer != nil {
+		recorder.idleTimer.Stop()
+		recorder.idleTimer = nil
+	}
+}
+
+func (recorder *containerLogRecorder) release() {
+	logRecordersMutex.Lock()
+	defer logRecordersMutex.Unlock()
+
+	recorder.readers--
+	if recorder.readers > 0 {
+		return
+	}
+
+	recorder.idleTimer = time.AfterFunc(recorder.idle, func() {
+		logRecordersMutex.Lock()
+		defer logRecordersMutex.Unlock()
+
+		if recorder.readers > 0 || recorder.stopping {
+			return
+		}
+
+		recorder.stopping = true
+		recorder.cancel()
+	})
+}
+
+// Only followed single container logs without an end are buffered, returns nil when buffering is disabled
+func logBufferConfig(ctx context.Context, request *agent.ContainerLogRequest) *config.Configuration {
+	if !request.GetStreaming() || request.Until != nil || isAggregatedLog(request) {
+		return nil
+	}
+
+	cfg, ok := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+	if !ok || cfg.LogBufferDir == "" || cfg.LogBufferSize <= 0 {
+		return nil
+	}
+
+	return cfg
+}
+
+// Removes the buffers of the containers that no longer exist
+func pruneLogBuffers(ctx context.Context, dir string) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Warn().Err(err).Str("dir", dir).Msg("Failed to list log buffers")
+		}
+		return
+	}
+
+	containers, err := docker.GetAllContainers(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to list containers for log buffer pruning")
+		return
+	}
+
+	existing := map[string]bool{}
+	for _, it := range containers {
+		existing[it.ID] = true
+	}
+
+	for _, it := range entries {
+		if !it.IsDir() || existing[it.Name()] {
+			continue
+		}
+
+		err = os.RemoveAll(filepath.Join(dir, it.Name()))
+		if err != nil {
+			log.Warn().Err(err).Str("containerId", it.Name()).Msg("Failed to remove log buffer")
+		}
+	}
+}
+
+// The offset of the first message to send, when the last `tail` lines are requested
+func tailOffset(last *agent.ContainerLogMessage, tail uint32) uint64 {
+	lastOffset := last.GetOffset()
+	if uint64(tail) >= lastOffset {
+		return 1
+	}
+
+	return lastOffset - uint64(tail) + 1
+}
+
+// The reader stops without an error event when the context is canceled
+func nextRecordedLogEvent(ctx context.Context, reader *DockerContainerLogReader) grpc.ContainerLogEvent {
+	select {
+	case <-ctx.Done():
+		return grpc.ContainerLogEvent{Error: ctx.Err()}
+	case event := <-reader.Next():
+		return event
+	}
+}
+
+func recordContainerLog(ctx context.Context,
+	cli client.APIClient,
+	containerID string,
+	recorder *containerLogRecorder,
+	reader *DockerContainerLogReader,
+) {
+	defer recorder.cancel()
+
+	var event grpc.ContainerLogEvent
+
+	for {
+		event = nextRecordedLogEvent(ctx, reader)
+		if event.Error != nil {
+			break
+		}
+
+		message := &agent.ContainerLogMessage{
+			Log:    event.Message,
+			Stream: event.Stream,
+		}
+		if !event.Timestamp.IsZero() {
+			message.Timestamp = timestamppb.New(event.Timestamp)
+		}
+
+		_, err := recorder.buffer.Append(message)
+		if err != nil {
+			log.Error().Err(err).Str("containerId", containerID).Msg("Failed to buffer container log")
+			break
+		}
+	}
+
+	err := reader.Close()
+	if err != nil {
+		log.Error().Err(err).Str("containerId", containerID).Msg("Failed to close container log reader")
+	}
+
+	// the streaming goroutine exits after sending its error
+	for event.Error == nil {
+		event = nextRecordedLogEvent(ctx, reader)
+	}
+
+	log.Debug().Err(event.Error).Str("containerId", containerID).Msg("Container log recording stopped")
+
+	_, err = cli.ContainerInspect(context.Background(), containerID)
+	removed := errdefs.IsNotFound(err)
+
+	logRecordersMutex.Lock()
+	recorder.stopping = true
+	logRecordersMutex.Unlock()
+
+	if removed {
+		err = recorder.buffer.Remove()
+	} else {
+		// kept, so the last lines of a stopped container can still be resumed
+		err = recorder.buffer.Close()
+	}
+	if err != nil {
+		log.Error().Err(err).Str("containerId", containerID).Msg("Failed to close container log buffer")
+	}
+
+	logRecordersMutex.Lock()
+	delete(logRecorders, containerID)
+	logRecordersMutex.Unlock()
+
+	close(recorder.done)
+}
+
+// Returns the recorder of the container, starting it when needed, and the offset where a new session starts
+func startLogRecorder(ctx context.Context,
+	cli client.APIClient,
+	containerID string,
+	tty bool,
+	options types.ContainerLogsOptions,
+	tail uint32,
+	cfg *config.Configuration,
+) (*containerLogRecorder, uint64, error) {
+	pruneLogBuffersOnce.Do(func() {
+		pruneLogBuffers(ctx, cfg.LogBufferDir)
+	})
+
+	logRecordersMutex.Lock()
+
+	for {
+		recorder, found := logRecorders[containerID]
+		if !found {
+			break
+		}
+
+		select {
+		case <-recorder.started:
+		default:
+			// another request is starting the recording
+			logRecordersMutex.Unlock()
+			<-recorder.started
+			logRecordersMutex.Lock()
+			continue
+		}
+
+		if !recorder.stopping {
+			recorder.acquire()
+			logRecordersMutex.Unlock()
+			return recorder, tailOffset(recorder.buffer.Last(), tail), nil
+		}
+
+		// an idle recording is stopping, its buffer has to be closed before opening it again
+		logRecordersMutex.Unlock()
+		<-recorder.done
+		logRecordersMutex.Lock()
+	}
+
+	// the buffer and the log are opened without the lock, the requests of the same container wait for started
+	recorder := &containerLogRecorder{
+		idle:    cfg.LogBufferIdle,
+		started: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	recorder.acquire()
+	logRecorders[containerID] = recorder
+
+	logRecordersMutex.Unlock()
+
+	failed := func(err error) (*containerLogRecorder, uint64, error) {
+		logRecordersMutex.Lock()
+		delete(logRecorders, containerID)
+		logRecordersMutex.Unlock()
+
+		close(recorder.started)
+		return nil, 0, err
+	}
+
+	buffer, err := logbuffer.Open(filepath.Join(cfg.LogBufferDir, containerID), cfg.LogBufferSize)
+	if err != nil {
+		return failed(err)
+	}
+
+	last := buffer.Last()
+
+	options.ShowStdout = true
+	options.ShowStderr = true
+	options.Follow = true
+	options.Until = ""
+	if last != nil {
+		// continue after the last recorded line
+		if last.Timestamp != nil {
+			options.Tail = "all"
+			options.Since = dockerTimestamp(timestamppb.New(last.Timestamp.AsTime().Add(time.Nanosecond)))
+		} else {
+			options.Tail = "0"
+		}
+	}
+
+	// the recording outlives the request, it stops when the log of the container ends or after being idle
+	recordCtx, cancel := context.WithCancel(context.Background())
+
+	reader, err := dockerContainerLog(recordCtx, cli, containerID, tty, options)
+	if err != nil {
+		cancel()
+		buffer.Close()
+		return failed(err)
+	}
+
+	recorder.buffer = buffer
+	recorder.cancel = cancel
+	close(recorder.started)
+
+	go recordContainerLog(recordCtx, cli, containerID, recorder, reader)
+
+	log.Debug().Str("containerId", containerID).Uint64("lastOffset", last.GetOffset()).Msg("Container log recording started")
+
+	return recorder, tailOffset(last, tail), nil
+}
+
+func streamBufferedLog(ctx context.Context,
+	reader *logbuffer.Reader,
+	request *agent.ContainerLogRequest,
+	eventChannel chan grpc.ContainerLogEvent,
+) {
+	stream := request.GetStream()
+	since := request.GetSince()
+	resuming := request.ResumeFrom != nil
+
+	for {
+		message, err := reader.Next(ctx)
+		if err != nil {
+			sendLogEvent(ctx, eventChannel, grpc.ContainerLogEvent{Error: err})
+			return
+		}
+
+		if resuming {
+			if message.Offset > request.GetResumeFrom()+1 {
+				log.Warn().Str("name", request.Name).Uint64("resumeFrom", request.GetResumeFrom()).
+					Uint64("available", message.Offset).Msg("Log buffer does not hold the requested offset, lines are lost")
+			}
+			resuming = false
+		}
+
+		if stream != agent.ContainerStream_CONTAINER_STREAM_UNSPECIFIED && message.Stream != stream {
+			continue
+		}
+
+		if since != nil && message.Timestamp != nil && message.Timestamp.AsTime().Before(since.AsTime()) {
+			continue
+		}
+
+		event := grpc.ContainerLogEvent{
+			Message: message.Log,
+			Stream:  message.Stream,
+			Offset:  message.Offset,
+			Error:   nil,
+		}
+		if message.Timestamp != nil {
+			event.Timestamp = message.Timestamp.AsTime()
+		}
+
+		if !sendLogEvent(ctx, eventChannel, event) {
+			return
+		}
+	}
+}
+
+func bufferedContainerLog(ctx context.Context,
+	cli client.APIClient,
+	containerID string,
+	tty bool,
+	request *agent.ContainerLogRequest,
+	options types.ContainerLogsOptions,
+	cfg *config.Configuration,
+) (*BufferedContainerLogReader, error) {
+	recorder, offset, err := startLogRecorder(ctx, cli, containerID, tty, options, request.GetTail(), cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// like the runtime, every line since the time is sent without a tail, older lines are skipped while streaming
+	if request.Since != nil && request.GetTail() == 0 {
+		offset = 1
+	}
+
+	if request.ResumeFrom != nil {
+		lastOffset := recorder.buffer.Last().GetOffset()
+		if request.GetResumeFrom() > lastOffset {
+			// offsets restart when the buffer is recreated, every line of the new one is unsent
+			log.Warn().Str("containerId", containerID).Uint64("resumeFrom", request.GetResumeFrom()).
+				Uint64("lastOffset", lastOffset).Msg("Resume offset is beyond the log buffer, sending the buffer from its start")
+			offset = 1
+		} else {
+			offset = request.GetResumeFrom() + 1
+		}
+	}
+
+	reader := recorder.buffer.NewReader(offset)
+	eventChannel := make(chan grpc.ContainerLogEvent)
+
+	go streamBufferedLog(ctx, reader, request, eventChannel)
+
+	return &BufferedContainerLogReader{
+		EventChannel: eventChannel,
+		Reader:       reader,
+		Recorder:     recorder,
+	}, nil
+}
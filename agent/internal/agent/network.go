@@ -0,0 +1,53 @@
+package agent
+
+import (
+	"context"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/dyrector-io/darklens/agent/internal/docker"
+	"github.com/dyrector-io/darklens/agent/internal/mapper"
+	"github.com/dyrector-io/darklens/protobuf/go/agent"
+)
+
+func NetworkList(ctx context.Context, _ *agent.NetworkListRequest) ([]*agent.NetworkItem, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	networks, err := cli.NetworkList(ctx, types.NetworkListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := docker.GetAllContainers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.MapNetworkList(networks, containers), nil
+}
+
+func NetworkInspect(ctx context.Context, request *agent.NetworkInspectRequest) (string, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return "", err
+	}
+
+	_, inspectionJSON, err := cli.NetworkInspectWithRaw(ctx, request.Id, types.NetworkInspectOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	return string(inspectionJSON), nil
+}
+
+func NetworkDelete(ctx context.Context, request *agent.NetworkDeleteRequest) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	return cli.NetworkRemove(ctx, request.Id)
+}
@@ -0,0 +1,121 @@
+package agent
+
+import (
+	"context"
+	"errors"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/client"
+	"github.com/dyrector-io/darklens/agent/internal/config"
+	"github.com/dyrector-io/darklens/agent/internal/docker"
+	"github.com/dyrector-io/darklens/agent/internal/grpc"
+	"github.com/dyrector-io/darklens/protobuf/go/agent"
+	"github.com/rs/zerolog/log"
+)
+
+// Calculating the size of volumes walks every file in them, so they are left out unless configured
+var defaultDiskUsageTypes = []types.DiskUsageObject{types.ImageObject, types.ContainerObject, types.BuildCacheObject}
+
+func diskUsageTypes(ctx context.Context) []types.DiskUsageObject {
+	cfg, ok := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+	if !ok || len(cfg.NodeDiskUsage) < 1 {
+		return defaultDiskUsageTypes
+	}
+
+	objects := []types.DiskUsageObject{}
+	for _, it := range cfg.NodeDiskUsage {
+		objects = append(objects, types.DiskUsageObject(it))
+	}
+
+	return objects
+}
+
+// The runtime rejects concurrent calculations and they are expensive, node info is sent without it on failure
+func nodeDiskUsage(ctx context.Context, cli client.APIClient) *agent.NodeDiskUsage {
+	usage, err := cli.DiskUsage(ctx, types.DiskUsageOptions{
+		Types: diskUsageTypes(ctx),
+	})
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to calculate disk usage")
+		return nil
+	}
+
+	return mapDiskUsage(&usage)
+}
+
+func mapDiskUsage(usage *types.DiskUsage) *agent.NodeDiskUsage {
+	diskUsage := &agent.NodeDiskUsage{
+		Images: usage.LayersSize,
+	}
+
+	for _, it := range usage.Containers {
+		diskUsage.Containers += it.SizeRw
+	}
+
+	for _, it := range usage.Volumes {
+		if it.UsageData != nil && it.UsageData.Size > 0 {
+			diskUsage.Volumes += it.UsageData.Size
+		}
+	}
+
+	for _, it := range usage.BuildCache {
+		if !it.Shared {
+			diskUsage.BuildCache += it.Size
+		}
+	}
+
+	diskUsage.Total = diskUsage.Images + diskUsage.Containers + diskUsage.Volumes + diskUsage.BuildCache
+
+	return diskUsage
+}
+
+func NodeInfo(ctx context.Context) (*agent.NodeInfoMessage, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	runtime, err := docker.GetContainerRuntime(ctx, cli)
+	if err != nil && !errors.Is(err, docker.ErrServerUnknown) {
+		return nil, err
+	}
+
+	serverVersion, err := cli.ServerVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := cli.Info(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes, err := cli.VolumeList(ctx, volume.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	networks, err := cli.NetworkList(ctx, types.NetworkListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &agent.NodeInfoMessage{
+		Runtime:         runtime,
+		RuntimeVersion:  serverVersion.Version,
+		Hostname:        info.Name,
+		OperatingSystem: info.OperatingSystem,
+		OsType:          info.OSType,
+		KernelVersion:   info.KernelVersion,
+		Architecture:    info.Architecture,
+		CpuCount:        int32(info.NCPU),
+		MemoryTotal:     info.MemTotal,
+		DockerRootDir:   info.DockerRootDir,
+		DiskUsage:       nodeDiskUsage(ctx, cli),
+		ImageCount:      int32(info.Images),
+		VolumeCount:     int32(len(volumes.Volumes)),
+		NetworkCount:    int32(len(networks)),
+		ContainerCount:  int32(info.Containers),
+	}, nil
+}
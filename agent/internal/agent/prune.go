@@ -0,0 +1,277 @@
+package agent
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/versions"
+	"github.com/docker/docker/client"
+	"github.com/dyrector-io/darklens/agent/internal/docker"
+	"github.com/dyrector-io/darklens/agent/internal/mapper"
+	"github.com/dyrector-io/darklens/protobuf/go/agent"
+)
+
+// Since API 1.42 only anonymous volumes are pruned unless 'all' is set
+const volumePruneAllAPIVersion = "1.42"
+
+func isPredefinedNetwork(name string) bool {
+	switch name {
+	case "bridge", "host", "none":
+		return true
+	default:
+		return false
+	}
+}
+
+func nonNegative(size int64) uint64 {
+	if size < 0 {
+		return 0
+	}
+
+	return uint64(size)
+}
+
+func pruneCandidateImages(usage *types.DiskUsage, unused bool) []*agent.SystemPruneItem {
+	items := []*agent.SystemPruneItem{}
+
+	for _, it := range usage.Images {
+		if it.Containers > 0 {
+			continue
+		}
+
+		name := ""
+		for _, tag := range it.RepoTags {
+			if tag != "<none>:<none>" {
+				name = tag
+				break
+			}
+		}
+
+		dangling := name == ""
+		if !dangling && !unused {
+			continue
+		}
+
+		size := it.Size
+		if it.SharedSize > 0 {
+			size -= it.SharedSize
+		}
+
+		items = append(items, &agent.SystemPruneItem{
+			Id:   it.ID,
+			Name: name,
+			Size: nonNegative(size),
+		})
+	}
+
+	return items
+}
+
+func pruneCandidateNetworks(ctx context.Context, cli client.APIClient) ([]*agent.SystemPruneItem, error) {
+	networks, err := cli.NetworkList(ctx, types.NetworkListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := docker.GetAllContainers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*agent.SystemPruneItem{}
+
+	for _, it := range mapper.MapNetworkList(networks, containers) {
+		if len(it.Containers) > 0 || isPredefinedNetwork(it.Name) {
+			continue
+		}
+
+		items = append(items, &agent.SystemPruneItem{
+			Id:   it.Id,
+			Name: it.Name,
+		})
+	}
+
+	return items, nil
+}
+
+func systemPruneDryRun(ctx context.Context, cli client.APIClient, request *agent.SystemPruneRequest) (*agent.SystemPruneMessage, error) {
+	usage, err := cli.DiskUsage(ctx, types.DiskUsageOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &agent.SystemPruneMessage{
+		DryRun: true,
+	}
+
+	if request.Containers {
+		for _, it := range usage.Containers {
+			switch it.State {
+			case "running", "paused", "restarting":
+				continue
+			}
+
+			result.Containers = append(result.Containers, &agent.SystemPruneItem{
+				Id:   it.ID,
+				Name: mapper.MapContainerName(it),
+				Size: nonNegative(it.SizeRw),
+			})
+		}
+	}
+
+	if request.DanglingImages || request.UnusedImages {
+		result.Images = pruneCandidateImages(&usage, request.UnusedImages)
+	}
+
+	if request.Volumes {
+		for _, it := range usage.Volumes {
+			if it.UsageData == nil || it.UsageData.RefCount > 0 {
+				continue
+			}
+
+			result.Volumes = append(result.Volumes, &agent.SystemPruneItem{
+				Id:   it.Name,
+				Name: it.Name,
+				Size: nonNegative(it.UsageData.Size),
+			})
+		}
+	}
+
+	if request.Networks {
+		result.Networks, err = pruneCandidateNetworks(ctx, cli)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if request.BuildCache {
+		for _, it := range usage.BuildCache {
+			if it.InUse {
+				continue
+			}
+
+			size := uint64(0)
+			if !it.Shared {
+				size = nonNegative(it.Size)
+			}
+
+			result.BuildCache = append(result.BuildCache, &agent.SystemPruneItem{
+				Id:   it.ID,
+				Name: it.Description,
+				Size: size,
+			})
+		}
+	}
+
+	for _, items := range [][]*agent.SystemPruneItem{result.Containers, result.Images, result.Volumes, result.BuildCache} {
+		for _, it := range items {
+			result.SpaceReclaimed += it.Size
+		}
+	}
+
+	return result, nil
+}
+
+func pruneItemsFromIDs(ids []string) []*agent.SystemPruneItem {
+	items := []*agent.SystemPruneItem{}
+	for _, id := range ids {
+		items = append(items, &agent.SystemPruneItem{
+			Id: id,
+		})
+	}
+
+	return items
+}
+
+// A failed step stops the prune, the result still reports what the earlier steps removed
+func systemPrune(ctx context.Context, cli client.APIClient, request *agent.SystemPruneRequest) (*agent.SystemPruneMessage, error) {
+	result := &agent.SystemPruneMessage{
+		DryRun: false,
+	}
+
+	if request.Containers {
+		report, err := cli.ContainersPrune(ctx, filters.NewArgs())
+		if err != nil {
+			return result, err
+		}
+
+		result.Containers = pruneItemsFromIDs(report.ContainersDeleted)
+		result.SpaceReclaimed += report.SpaceReclaimed
+	}
+
+	if request.DanglingImages || request.UnusedImages {
+		report, err := cli.ImagesPrune(ctx, filters.NewArgs(filters.Arg("dangling", strconv.FormatBool(!request.UnusedImages))))
+		if err != nil {
+			return result, err
+		}
+
+		for _, it := range report.ImagesDeleted {
+			if it.Deleted != "" {
+				result.Images = append(result.Images, &agent.SystemPruneItem{
+					Id: it.Deleted,
+				})
+			}
+		}
+		result.SpaceReclaimed += report.SpaceReclaimed
+	}
+
+	if request.Volumes {
+		cli.NegotiateAPIVersion(ctx)
+
+		args := filters.NewArgs()
+		if versions.GreaterThanOrEqualTo(cli.ClientVersion(), volumePruneAllAPIVersion) {
+			args.Add("all", "true")
+		}
+
+		report, err := cli.VolumesPrune(ctx, args)
+		if err != nil {
+			return result, err
+		}
+
+		result.Volumes = pruneItemsFromIDs(report.VolumesDeleted)
+		result.SpaceReclaimed += report.SpaceReclaimed
+	}
+
+	if request.Networks {
+		report, err := cli.NetworksPrune(ctx, filters.NewArgs())
+		if err != nil {
+			return result, err
+		}
+
+		// networks are reported by name
+		for _, name := range report.NetworksDeleted {
+			result.Networks = append(result.Networks, &agent.SystemPruneItem{
+				Name: name,
+			})
+		}
+	}
+
+	if request.BuildCache {
+		report, err := cli.BuildCachePrune(ctx, types.BuildCachePruneOptions{
+			All: true,
+		})
+		if err != nil {
+			return result, err
+		}
+
+		result.BuildCache = pruneItemsFromIDs(report.CachesDeleted)
+		result.SpaceReclaimed += report.SpaceReclaimed
+	}
+
+	return result, nil
+}
+
+func SystemPrune(ctx context.Context, request *agent.SystemPruneRequest) (*agent.SystemPruneMessage, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	if request.DryRun {
+		return systemPruneDryRun(ctx, cli, request)
+	}
+
+	return systemPrune(ctx, cli, request)
+}
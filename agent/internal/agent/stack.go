@@ -0,0 +1,76 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+	"github.com/dyrector-io/darklens/agent/internal/docker"
+	"github.com/dyrector-io/darklens/agent/internal/mapper"
+	"github.com/dyrector-io/darklens/protobuf/go/agent"
+	"github.com/rs/zerolog/log"
+)
+
+func stackContainerCommand(ctx context.Context, cli client.APIClient, cont *types.Container, command *agent.StackCommandRequest) error {
+	switch command.Operation {
+	case agent.StackOperation_START_STACK:
+		return cli.ContainerStart(ctx, cont.ID, types.ContainerStartOptions{})
+	case agent.StackOperation_STOP_STACK:
+		return cli.ContainerStop(ctx, cont.ID, stopOptions(command.Timeout, command.GetSignal()))
+	case agent.StackOperation_RESTART_STACK:
+		return cli.ContainerRestart(ctx, cont.ID, stopOptions(command.Timeout, command.GetSignal()))
+	case agent.StackOperation_DELETE_STACK:
+		return docker.DeleteContainer(ctx, cont)
+	default:
+		return errdefs.InvalidParameter(fmt.Errorf("unknown stack operation: %s", command.Operation))
+	}
+}
+
+func StackCommand(ctx context.Context, command *agent.StackCommandRequest) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	project := command.Project
+	if project == "" {
+		return errdefs.InvalidParameter(errors.New("stack command without a project"))
+	}
+
+	if command.Operation == agent.StackOperation_STACK_OPERATION_UNSPECIFIED {
+		return errdefs.InvalidParameter(errors.New("stack command without an operation"))
+	}
+
+	containers, err := docker.GetAllContainersByLabel(ctx, fmt.Sprintf("%s=%s", docker.ComposeProjectLabel, project))
+	if err != nil {
+		return err
+	}
+
+	if len(containers) < 1 {
+		return fmt.Errorf("%w: no container in compose project %s", docker.ErrContainerNotFound, project)
+	}
+
+	sort.Slice(containers, func(i, j int) bool {
+		return mapper.MapContainerName(&containers[i]) < mapper.MapContainerName(&containers[j])
+	})
+
+	errs := []error{}
+	for i := range containers {
+		cont := &containers[i]
+		name := mapper.MapContainerName(cont)
+
+		log.Debug().Str("project", project).Str("name", name).Str("operation", command.Operation.String()).Msg("Stack container command")
+
+		err = stackContainerCommand(ctx, cli, cont, command)
+		if err != nil {
+			log.Error().Err(err).Str("project", project).Str("name", name).Msg("Stack container command error")
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
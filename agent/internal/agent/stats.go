@@ -0,0 +1,105 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/dyrector-io/darklens/agent/internal/docker"
+	"github.com/dyrector-io/darklens/agent/internal/grpc"
+	"github.com/dyrector-io/darklens/agent/internal/mapper"
+	"github.com/dyrector-io/darklens/protobuf/go/agent"
+)
+
+type DockerContainerStatsReader struct {
+	EventChannel chan grpc.ContainerStatsEvent
+	Reader       io.ReadCloser
+
+	grpc.ContainerStatsReader
+}
+
+func (dockerReader *DockerContainerStatsReader) Next() <-chan grpc.ContainerStatsEvent {
+	return dockerReader.EventChannel
+}
+
+func (dockerReader *DockerContainerStatsReader) Close() error {
+	return dockerReader.Reader.Close()
+}
+
+func streamDockerStats(ctx context.Context, reader io.Reader, interval time.Duration, eventChannel chan grpc.ContainerStatsEvent) {
+	decoder := json.NewDecoder(reader)
+
+	var previous *types.StatsJSON
+
+	for {
+		current := &types.StatsJSON{}
+
+		err := decoder.Decode(current)
+		if err != nil {
+			if ctx.Err() != nil {
+				err = ctx.Err()
+			}
+
+			select {
+			case <-ctx.Done():
+			case eventChannel <- grpc.ContainerStatsEvent{Error: err}:
+			}
+			return
+		}
+
+		// The runtime samples roughly every second, skip until the requested interval elapsed
+		if previous != nil && current.Read.Sub(previous.Read) < interval {
+			continue
+		}
+
+		event := grpc.ContainerStatsEvent{
+			Stats: mapper.MapContainerStats(current, previous),
+			Error: nil,
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case eventChannel <- event:
+		}
+
+		previous = current
+	}
+}
+
+func ContainerStats(ctx context.Context, request *agent.ContainerStatsRequest) (grpc.ContainerStatsReader, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	name := request.Name
+
+	cont, err := docker.GetContainerByName(ctx, cli, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if cont == nil {
+		return nil, fmt.Errorf("%w: %s", docker.ErrContainerNotFound, name)
+	}
+
+	stats, err := cli.ContainerStats(ctx, cont.ID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	interval := time.Duration(request.GetInterval()) * time.Second
+	eventChannel := make(chan grpc.ContainerStatsEvent)
+
+	go streamDockerStats(ctx, stats.Body, interval, eventChannel)
+
+	return &DockerContainerStatsReader{
+		EventChannel: eventChannel,
+		Reader:       stats.Body,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package agent
+
+import (
+	"context"
+
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/client"
+	"github.com/dyrector-io/darklens/agent/internal/docker"
+	"github.com/dyrector-io/darklens/agent/internal/mapper"
+	"github.com/dyrector-io/darklens/protobuf/go/agent"
+)
+
+func VolumeList(ctx context.Context, _ *agent.VolumeListRequest) ([]*agent.VolumeItem, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	volumes, err := cli.VolumeList(ctx, volume.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := docker.GetAllContainers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.MapVolumeList(volumes.Volumes, containers), nil
+}
+
+func VolumeInspect(ctx context.Context, request *agent.VolumeInspectRequest) (string, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return "", err
+	}
+
+	_, inspectionJSON, err := cli.VolumeInspectWithRaw(ctx, request.Name)
+	if err != nil {
+		return "", err
+	}
+
+	return string(inspectionJSON), nil
+}
+
+func VolumeDelete(ctx context.Context, request *agent.VolumeDeleteRequest) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	return cli.VolumeRemove(ctx, request.Name, request.Force)
+}
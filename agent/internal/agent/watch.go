@@ -3,17 +3,53 @@
 import (
 	"context"
 	"errors"
+	"sync"
+	"time"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/events"
 	"github.com/docker/docker/client"
+	"github.com/dyrector-io/darklens/agent/internal/config"
 	"github.com/dyrector-io/darklens/agent/internal/grpc"
 	"github.com/dyrector-io/darklens/agent/internal/mapper"
 	"github.com/dyrector-io/darklens/agent/internal/docker"
 	"github.com/dyrector-io/darklens/protobuf/go/agent"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func messageToStateItem(ctx context.Context, event *events.Message) (*agent.ContainerStateItem, error) {
+// Limits the concurrent inspections of a snapshot, so hosts with many containers do not flood the runtime
+const maxConcurrentInspections = 8
+
+// Fills the restart count and the exit code, a failed inspection leaves the listed state as is
+func inspectContainerDetails(ctx context.Context, cli client.APIClient, items []*agent.ContainerStateItem) {
+	limit := make(chan struct{}, maxConcurrentInspections)
+	var wg sync.WaitGroup
+
+	for _, it := range items {
+		limit <- struct{}{}
+		wg.Add(1)
+
+		go func(it *agent.ContainerStateItem) {
+			defer func() {
+				<-limit
+				wg.Done()
+			}()
+
+			inspect, err := cli.ContainerInspect(ctx, it.Id)
+			if err != nil {
+				log.Warn().Err(err).Str("name", it.Name).Msg("Failed to inspect watched container")
+				return
+			}
+
+			mapper.MapContainerDetails(it, &inspect)
+		}(it)
+	}
+
+	wg.Wait()
+}
+
+func messageToStateItem(ctx context.Context, cli client.APIClient, event *events.Message) (*agent.ContainerStateItem, error) {
 	// Only check container events, ignored events include image, volume, network, daemons, etc.
 	if event.Type != "container" {
 		return nil, nil
@@ -26,6 +62,7 @@ func messageToStateItem(ctx context.Context, event *events.Message) (*agent.Cont
 
 	if event.Action == "destroy" {
 		return &agent.ContainerStateItem{
+			Id:        event.Actor.ID,
 			Name:      name,
 			Command:   "",
 			CreatedAt: nil,
@@ -34,12 +71,19 @@ func messageToStateItem(ctx context.Context, event *events.Message) (*agent.Cont
 			Ports:     []*agent.ContainerStateItemPort{},
 			ImageName: "",
 			ImageTag:  "",
+
+			// the container is gone, the labels are only available as event attributes
+			ComposeProject: event.Actor.Attributes[docker.ComposeProjectLabel],
+			ComposeService: event.Actor.Attributes[docker.ComposeServiceLabel],
 		}, nil
 	}
 
 	containerState := mapper.MapDockerContainerEventToContainerState(event.Action)
-	// Ingored events are mapped to unspecified, for example tty, exec, oom, etc.
-	if containerState == agent.ContainerState_CONTAINER_STATE_UNSPECIFIED {
+	// health_status, oom and kill keep the listed state, but are sent with their reason
+	stateEvent := mapper.MapDockerContainerEventToStateEvent(event.Action)
+	// Ingored events are mapped to unspecified, for example tty, exec, etc.
+	if containerState == agent.ContainerState_CONTAINER_STATE_UNSPECIFIED &&
+		stateEvent == agent.ContainerStateEvent_CONTAINER_STATE_EVENT_UNSPECIFIED {
 		return nil, nil
 	}
 
@@ -48,52 +92,198 @@ func messageToStateItem(ctx context.Context, event *events.Message) (*agent.Cont
 		return nil, err
 	}
 
+	// removed since the event, the destroy event follows
+	if container == nil {
+		return nil, nil
+	}
+
 	newState := mapper.MapContainerState(container)
-	newState.State = containerState
+	if containerState != agent.ContainerState_CONTAINER_STATE_UNSPECIFIED {
+		newState.State = containerState
+	}
+	if stateEvent != agent.ContainerStateEvent_CONTAINER_STATE_EVENT_UNSPECIFIED {
+		newState.Event = stateEvent
+		newState.Reason = mapper.MapDockerContainerEventReason(event.Action, event.Actor.Attributes)
+	}
+	inspectContainerDetails(ctx, cli, []*agent.ContainerStateItem{newState})
 	return newState, nil
 }
 
-func WatchContainers(ctx context.Context) (*grpc.ContainerWatchContext, error) {
-	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
-	if err != nil {
-		return nil, err
+const (
+	watchReconnectMinDelay = time.Second
+	watchReconnectMaxDelay = 30 * time.Second
+)
+
+func watchResyncInterval(ctx context.Context) time.Duration {
+	cfg, ok := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+	if !ok {
+		return 0
 	}
 
+	return cfg.WatchResync
+}
+
+// Lists every container as a snapshot, and the time to follow the events from
+func listContainerSnapshot(ctx context.Context, cli client.APIClient) (grpc.ContainerWatchEvent, time.Time, error) {
+	// events during the listing are replayed, a few might be sent twice
+	listedAt := time.Now()
+
 	containers, err := docker.GetAllContainers(ctx)
 	if err != nil {
-		return nil, err
+		return grpc.ContainerWatchEvent{}, listedAt, err
 	}
 
-	eventChannel := make(chan []*agent.ContainerStateItem)
-	errorChannel := make(chan error)
+	items := mapper.MapContainerStateList(containers)
+	inspectContainerDetails(ctx, cli, items)
 
-	chanMessages, chanErrors := cli.Events(ctx, types.EventsOptions{})
+	return grpc.ContainerWatchEvent{
+		Items:    items,
+		Snapshot: true,
+	}, listedAt, nil
+}
 
-	go func(ctx context.Context, chanMessages <-chan events.Message, chanErrors <-chan error) {
-		eventChannel <- mapper.MapContainerStateList(containers)
+func sendWatchEvent(ctx context.Context, eventChannel chan grpc.ContainerWatchEvent, event grpc.ContainerWatchEvent) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case eventChannel <- event:
+		return true
+	}
+}
 
-		for {
+// Waits before the next reconnect attempt, returns the delay of the one after
+func waitReconnect(ctx context.Context, delay time.Duration) (time.Duration, bool) {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return delay, false
+	case <-timer.C:
+	}
+
+	delay *= 2
+	if delay > watchReconnectMaxDelay {
+		delay = watchReconnectMaxDelay
+	}
+
+	return delay, true
+}
+
+// Sends a snapshot on start, after reconnecting to the runtime and periodically, and the changed items in between
+func watchContainers(ctx context.Context,
+	cli client.APIClient,
+	snapshot grpc.ContainerWatchEvent,
+	listedAt time.Time,
+	eventChannel chan grpc.ContainerWatchEvent,
+) {
+	var resync <-chan time.Time
+	resyncInterval := watchResyncInterval(ctx)
+	if resyncInterval > 0 {
+		resyncTicker := time.NewTicker(resyncInterval)
+		defer resyncTicker.Stop()
+
+		resync = resyncTicker.C
+	}
+
+	reconnectDelay := watchReconnectMinDelay
+
+	for {
+		if !sendWatchEvent(ctx, eventChannel, snapshot) {
+			return
+		}
+
+		eventsCtx, cancelEvents := context.WithCancel(ctx)
+		chanMessages, chanErrors := cli.Events(eventsCtx, types.EventsOptions{
+			Since: dockerTimestamp(timestamppb.New(listedAt)),
+		})
+
+		connected := true
+		for connected {
 			select {
 			case <-ctx.Done():
+				cancelEvents()
 				return
 			case eventError := <-chanErrors:
-				errorChannel <- eventError
-				return
-			case eventMessage := <-chanMessages:
-				var changed *agent.ContainerStateItem
-				changed, err = messageToStateItem(ctx, &eventMessage)
+				if ctx.Err() != nil {
+					cancelEvents()
+					return
+				}
+
+				log.Warn().Err(eventError).Msg("Container events disconnected, reconnecting")
+				connected = false
+			case <-resync:
+				var err error
+				snapshot, _, err = listContainerSnapshot(ctx, cli)
 				if err != nil {
-					errorChannel <- err
+					log.Warn().Err(err).Msg("Failed to list containers for resync")
+					continue
+				}
+
+				if !sendWatchEvent(ctx, eventChannel, snapshot) {
+					cancelEvents()
 					return
-				} else if changed != nil {
-					eventChannel <- []*agent.ContainerStateItem{
+				}
+			case eventMessage := <-chanMessages:
+				reconnectDelay = watchReconnectMinDelay
+
+				changed, err := messageToStateItem(ctx, cli, &eventMessage)
+				if err != nil {
+					// the next snapshot corrects the state
+					log.Warn().Err(err).Str("action", eventMessage.Action).Msg("Failed to map container event")
+					continue
+				}
+
+				crashLoops.check(&eventMessage, changed)
+				if changed != nil && !sendWatchEvent(ctx, eventChannel, grpc.ContainerWatchEvent{
+					Items: []*agent.ContainerStateItem{
 						changed,
-					}
+					},
+				}) {
+					cancelEvents()
+					return
 				}
+			}
+		}
+
+		cancelEvents()
+
+		// the runtime might be restarting, retry until it is available again
+		for {
+			var ok bool
+			reconnectDelay, ok = waitReconnect(ctx, reconnectDelay)
+			if !ok {
+				return
+			}
+
+			var err error
+			snapshot, listedAt, err = listContainerSnapshot(ctx, cli)
+			if err == nil {
 				break
 			}
+
+			log.Warn().Err(err).Stringer("retryIn", reconnectDelay).Msg("Failed to list containers after disconnect")
 		}
-	}(ctx, chanMessages, chanErrors)
+
+		log.Info().Msg("Container events reconnected")
+	}
+}
+
+func WatchContainers(ctx context.Context) (*grpc.ContainerWatchContext, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, listedAt, err := listContainerSnapshot(ctx, cli)
+	if err != nil {
+		return nil, err
+	}
+
+	eventChannel := make(chan grpc.ContainerWatchEvent)
+	errorChannel := make(chan error)
+
+	go watchContainers(ctx, cli, snapshot, listedAt, eventChannel)
 
 	return &grpc.ContainerWatchContext{
 		Events: eventChannel,
@@ -20,6 +20,27 @@ type Configuration struct {
 	GrpcKeepalive      time.Duration `yaml:"grpcKeepalive"            env:"GRPC_KEEPALIVE"              env-default:"60s"`
 	GrpcToken          string        `yaml:"grpcToken"                env:"GRPC_TOKEN"                  env-default:""`
 	HostDockerSockPath string        `yaml:"hostDockerSockPath"     env:"HOST_DOCKER_SOCK_PATH" env-default:"/var/run/docker.sock"`
+	NodeInfoInterval   time.Duration `yaml:"nodeInfoInterval"         env:"NODE_INFO_INTERVAL"          env-default:"5m"`
+	NodeDiskUsage      []string      `yaml:"nodeDiskUsage"            env:"NODE_DISK_USAGE"             env-default:"image,container,build-cache"`
+	GrpcCompression    bool          `yaml:"grpcCompression"          env:"GRPC_COMPRESSION"            env-default:"false"`
+	GrpcBackoffMin     time.Duration `yaml:"grpcBackoffMin"           env:"GRPC_BACKOFF_MIN"            env-default:"1s"`
+	GrpcBackoffMax     time.Duration `yaml:"grpcBackoffMax"           env:"GRPC_BACKOFF_MAX"            env-default:"2m"`
+	GrpcBackoffJitter  float64       `yaml:"grpcBackoffJitter"        env:"GRPC_BACKOFF_JITTER"         env-default:"0.5"`
+	LogBatchSize       int           `yaml:"logBatchSize"             env:"LOG_BATCH_SIZE"              env-default:"65536"`
+	LogBatchWindow     time.Duration `yaml:"logBatchWindow"           env:"LOG_BATCH_WINDOW"            env-default:"100ms"`
+	LogBufferDir       string        `yaml:"logBufferDir"             env:"LOG_BUFFER_DIR"              env-default:""`
+	LogBufferSize      int64         `yaml:"logBufferSize"            env:"LOG_BUFFER_SIZE"             env-default:"8388608"`
+	LogBufferIdle      time.Duration `yaml:"logBufferIdle"            env:"LOG_BUFFER_IDLE"             env-default:"5m"`
+	CrashLoopRestarts  int           `yaml:"crashLoopRestarts"        env:"CRASH_LOOP_RESTARTS"         env-default:"5"`
+	CrashLoopWindow    time.Duration `yaml:"crashLoopWindow"          env:"CRASH_LOOP_WINDOW"           env-default:"5m"`
+	WatchResync        time.Duration `yaml:"watchResync"              env:"WATCH_RESYNC"                env-default:"5m"`
+
+	// Without a CA file, system roots or fingerprints the certificate presented by the backend is trusted
+	GrpcCaFile           string   `yaml:"grpcCaFile"               env:"GRPC_CA_FILE"                env-default:""`
+	GrpcSystemRoots      bool     `yaml:"grpcSystemRoots"          env:"GRPC_SYSTEM_ROOTS"           env-default:"false"`
+	GrpcCertFingerprints []string `yaml:"grpcCertFingerprints"     env:"GRPC_CERT_FINGERPRINTS"      env-separator:","`
+	GrpcClientCert       string   `yaml:"grpcClientCert"           env:"GRPC_CLIENT_CERT"            env-default:""`
+	GrpcClientKey        string   `yaml:"grpcClientKey"            env:"GRPC_CLIENT_KEY"             env-default:""`
 
 	// gRPC token is set separately, because nested structures are not yet suppported in cleanenv
 	JwtToken *ValidJWT
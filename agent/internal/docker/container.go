@@ -146,13 +146,16 @@ func GetContainerByName(ctx context.Context, cli client.APIClient, nameFilter st
 	return checkOneContainer(containers)
 }
 
-func DeleteImage(ctx context.Context, imageID string) error {
+func DeleteImage(ctx context.Context, imageID string, force bool) error {
 	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 	if err != nil {
 		log.Fatal().Err(err).Send()
 	}
 
-	_, err = cli.ImageRemove(ctx, imageID, types.ImageRemoveOptions{})
+	_, err = cli.ImageRemove(ctx, imageID, types.ImageRemoveOptions{
+		Force:         force,
+		PruneChildren: true,
+	})
 	return err
 }
 
@@ -164,7 +167,7 @@ func checkOneContainer(containers []types.Container) (*types.Container, error) {
 	case 1:
 		return &containers[0], nil
 	default:
-		return nil, fmt.Errorf("more than one matching container")
+		return nil, ErrAmbiguousContainer
 	}
 }
 
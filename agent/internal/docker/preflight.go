@@ -20,7 +20,7 @@ func versionCheck(ctx context.Context, cli client.APIClient) (*zerolog.Event, er
 		return nil, err
 	}
 
-	runtime, err := getContainerRuntime(ctx, cli)
+	runtime, err := GetContainerRuntime(ctx, cli)
 	if err != nil {
 		return nil, err
 	}
@@ -77,7 +77,7 @@ func satisfyVersion(minimumVer, preferredVer, actualVer string) error {
 	return nil
 }
 
-func getContainerRuntime(ctx context.Context, cli client.APIClient) (string, error) {
+func GetContainerRuntime(ctx context.Context, cli client.APIClient) (string, error) {
 	info, err := cli.Info(ctx)
 	if err != nil {
 		return "", err
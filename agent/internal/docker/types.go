@@ -17,6 +17,8 @@
 	RecommendedPodmanServerVersion = "4.4.0"
 	PodmanHost                     = "host.containers.internal"
 	DockerHost                     = "host.docker.internal"
+	ComposeProjectLabel            = "com.docker.compose.project"
+	ComposeServiceLabel            = "com.docker.compose.service"
 )
 
 var (
@@ -30,6 +32,8 @@
 	ErrCannotGetServerInformation     = errors.New("cannot get server information")
 	ErrCannotGetServerVersion         = errors.New("cannot get server version")
 	ErrCannotSatisfyVersionConstraint = errors.New("cannot satisfy version constraint")
+	ErrContainerNotFound              = errors.New("container not found")
+	ErrAmbiguousContainer             = errors.New("more than one matching container")
 )
 
 type DockerVersion struct {
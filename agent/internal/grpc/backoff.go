@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/dyrector-io/darklens/agent/internal/config"
+	"github.com/dyrector-io/darklens/agent/internal/health"
+	"github.com/rs/zerolog/log"
+)
+
+// Exponential backoff between reconnect attempts. The jitter spreads the agents reconnecting after a
+// backend restart, otherwise they would retry in lockstep.
+type backoff struct {
+	min     time.Duration
+	max     time.Duration
+	jitter  float64
+	attempt int
+}
+
+func newBackoff(appConfig *config.Configuration) *backoff {
+	minDelay := appConfig.GrpcBackoffMin
+	if minDelay <= 0 {
+		minDelay = time.Second
+	}
+
+	maxDelay := appConfig.GrpcBackoffMax
+	if maxDelay < minDelay {
+		maxDelay = minDelay
+	}
+
+	jitter := appConfig.GrpcBackoffJitter
+	if jitter < 0 {
+		jitter = 0
+	} else if jitter > 1 {
+		jitter = 1
+	}
+
+	return &backoff{
+		min:    minDelay,
+		max:    maxDelay,
+		jitter: jitter,
+	}
+}
+
+// The delay of the next attempt, doubled after every attempt up to the maximum and reduced by a random part of the jitter
+func (b *backoff) next() time.Duration {
+	delay := b.min
+	for i := 0; i < b.attempt && delay < b.max; i++ {
+		delay *= 2
+	}
+	if delay > b.max {
+		delay = b.max
+	}
+
+	b.attempt++
+
+	// #nosec G404 -- the jitter is not security sensitive
+	return delay - time.Duration(b.jitter*rand.Float64()*float64(delay))
+}
+
+func (b *backoff) reset() {
+	b.attempt = 0
+}
+
+// Waits before the next attempt and reports it in the health status, returns false when the context is done
+func (b *backoff) wait(ctx context.Context, lastErr error) bool {
+	delay := b.next()
+
+	log.Info().Int("attempt", b.attempt).Stringer("delay", delay).Msg("Retrying connection")
+	health.SetHealthRetryStatus(b.attempt, time.Now().Add(delay), lastErr)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
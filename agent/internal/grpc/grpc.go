@@ -1,8 +1,12 @@
 package grpc
 
 import (
+	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -15,18 +19,21 @@
 
 	"github.com/dyrector-io/darklens/agent/internal/config"
 	"github.com/dyrector-io/darklens/agent/internal/health"
+	"github.com/dyrector-io/darklens/agent/internal/mapper"
 	"github.com/dyrector-io/darklens/agent/internal/utils"
 	"github.com/dyrector-io/darklens/agent/internal/version"
 	"github.com/dyrector-io/darklens/protobuf/go/agent"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	grpcgzip "google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Connection struct {
@@ -41,8 +48,15 @@ type ConnectionParams struct {
 }
 
 type ContainerLogEvent struct {
-	Message string
-	Error   error
+	Message    string
+	Timestamp  time.Time
+	Stream     agent.ContainerStream
+	Structured *agent.StructuredLog
+	// Position in the log buffer, 0 when the log is not buffered
+	Offset uint64
+	// Set when the logs of several containers are aggregated
+	Container string
+	Error     error
 }
 
 type ContainerLogReader interface {
@@ -55,8 +69,59 @@ type ContainerLogContext struct {
 	Echo   bool
 }
 
+type ContainerExecEvent struct {
+	Stream agent.ContainerStream
+	Data   []byte
+	Error  error
+}
+
+type ContainerExecSession interface {
+	Next() <-chan ContainerExecEvent
+	Write(data []byte) error
+	CloseWrite() error
+	Resize(ctx context.Context, width, height uint32) error
+	ExitCode(ctx context.Context) (int32, error)
+	Close() error
+}
+
+type ContainerStatsEvent struct {
+	Stats *agent.ContainerStatsMessage
+	Error error
+}
+
+type ContainerStatsReader interface {
+	Next() <-chan ContainerStatsEvent
+	Close() error
+}
+
+type ContainerEventsEvent struct {
+	Event *agent.ContainerEventMessage
+	Error error
+}
+
+type ContainerEventsReader interface {
+	Next() <-chan ContainerEventsEvent
+	Close() error
+}
+
+type ImagePullEvent struct {
+	Progress *agent.ImagePullMessage
+	Error    error
+}
+
+type ImagePullReader interface {
+	Next() <-chan ImagePullEvent
+	Close() error
+}
+
+type ContainerWatchEvent struct {
+	Items []*agent.ContainerStateItem
+	// Items is the complete list of containers
+	Snapshot bool
+}
+
 type ContainerWatchContext struct {
-	Events chan []*agent.ContainerStateItem
+	Events chan ContainerWatchEvent
 	Error  chan error
 }
 
@@ -68,23 +133,62 @@ type ClientLoop struct {
 }
 
 type (
-	WatchFunc            func(context.Context) (*ContainerWatchContext, error)
-	CloseFunc            func(context.Context, agent.CloseReason) error
-	ContainerCommandFunc func(context.Context, *agent.ContainerCommandRequest) error
-	ContainerDeleteFunc  func(context.Context, *agent.ContainerDeleteRequest) error
-	ContainerLogFunc     func(context.Context, *agent.ContainerLogRequest) (*ContainerLogContext, error)
-	ContainerInspectFunc func(context.Context, *agent.ContainerInspectRequest) (string, error)
+	WatchFunc              func(context.Context) (*ContainerWatchContext, error)
+	CloseFunc              func(context.Context, agent.CloseReason) error
+	ContainerCommandFunc   func(context.Context, *agent.ContainerCommandRequest) error
+	StackCommandFunc       func(context.Context, *agent.StackCommandRequest) error
+	ContainerDeleteFunc    func(context.Context, *agent.ContainerDeleteRequest) error
+	ContainerLogFunc       func(context.Context, *agent.ContainerLogRequest) (*ContainerLogContext, error)
+	ContainerLogExportFunc func(context.Context, *agent.ContainerLogExportRequest) (io.ReadCloser, error)
+	ContainerInspectFunc   func(context.Context, *agent.ContainerInspectRequest) (string, error)
+	ContainerExecFunc      func(context.Context, *agent.ContainerExecRequest) (ContainerExecSession, error)
+	ContainerStatsFunc     func(context.Context, *agent.ContainerStatsRequest) (ContainerStatsReader, error)
+	ContainerEventsFunc    func(context.Context, *agent.ContainerEventsRequest) (ContainerEventsReader, error)
+	NodeInfoFunc           func(context.Context) (*agent.NodeInfoMessage, error)
+	ImageListFunc          func(context.Context, *agent.ImageListRequest) ([]*agent.ImageItem, error)
+	ImageInspectFunc       func(context.Context, *agent.ImageInspectRequest) (string, error)
+	ImageDeleteFunc        func(context.Context, *agent.ImageDeleteRequest) error
+	ImagePullFunc          func(context.Context, *agent.ImagePullRequest) (ImagePullReader, error)
+	VolumeListFunc         func(context.Context, *agent.VolumeListRequest) ([]*agent.VolumeItem, error)
+	VolumeInspectFunc      func(context.Context, *agent.VolumeInspectRequest) (string, error)
+	VolumeDeleteFunc       func(context.Context, *agent.VolumeDeleteRequest) error
+	NetworkListFunc        func(context.Context, *agent.NetworkListRequest) ([]*agent.NetworkItem, error)
+	NetworkInspectFunc     func(context.Context, *agent.NetworkInspectRequest) (string, error)
+	NetworkDeleteFunc      func(context.Context, *agent.NetworkDeleteRequest) error
+	SystemPruneFunc        func(context.Context, *agent.SystemPruneRequest) (*agent.SystemPruneMessage, error)
 )
 
 type WorkerFunctions struct {
-	Watch            WatchFunc
-	Close            CloseFunc
-	ContainerCommand ContainerCommandFunc
-	ContaierDelete   ContainerDeleteFunc
-	ContainerLog     ContainerLogFunc
-	ContainerInspect ContainerInspectFunc
+	Watch              WatchFunc
+	Close              CloseFunc
+	ContainerCommand   ContainerCommandFunc
+	StackCommand       StackCommandFunc
+	ContaierDelete     ContainerDeleteFunc
+	ContainerLog       ContainerLogFunc
+	ContainerLogExport ContainerLogExportFunc
+	ContainerInspect   ContainerInspectFunc
+	ContainerExec      ContainerExecFunc
+	ContainerStats     ContainerStatsFunc
+	ContainerEvents    ContainerEventsFunc
+	NodeInfo           NodeInfoFunc
+	ImageList          ImageListFunc
+	ImageInspect       ImageInspectFunc
+	ImageDelete        ImageDeleteFunc
+	ImagePull          ImagePullFunc
+	VolumeList         VolumeListFunc
+	VolumeInspect      VolumeInspectFunc
+	VolumeDelete       VolumeDeleteFunc
+	NetworkList        NetworkListFunc
+	NetworkInspect     NetworkInspectFunc
+	NetworkDelete      NetworkDeleteFunc
+	SystemPrune        SystemPruneFunc
 }
 
+var (
+	ErrNotImplemented = errors.New("function not implemented")
+	ErrUnknownCommand = errors.New("unknown agent command")
+)
+
 type contextKey int
 
 const (
@@ -116,16 +220,22 @@ func (g *Connection) SetConn(conn *grpc.ClientConn) {
 // Singleton instance
 var grpcConn *Connection
 
-func fetchCertificatesFromURL(ctx context.Context, addr string) (*x509.CertPool, error) {
+func fetchCertificatesFromURL(ctx context.Context, addr string, tlsConfig *tls.Config) (*x509.CertPool, error) {
 	log.Info().Msg("Retrieving certificate")
 
+	// the client certificate is presented, backends requiring mutual TLS would reject the handshake otherwise
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig.Clone()
+	client := &http.Client{Transport: transport}
+	defer client.CloseIdleConnections()
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodHead, addr, http.NoBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create the http request: %s", err.Error())
 	}
 
 	//nolint:bodyclose //closed already
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request for certificates: %s", err.Error())
 	}
@@ -153,6 +263,62 @@ func fetchCertificatesFromURL(ctx context.Context, addr string) (*x509.CertPool,
 	return pool, nil
 }
 
+func dialBackend(ctx context.Context,
+	address string,
+	parsedUrl *url.URL,
+	tlsConfig *tls.Config,
+	appConfig *config.Configuration,
+) (*grpc.ClientConn, error) {
+	var creds credentials.TransportCredentials
+
+	if parsedUrl.Scheme == "https" {
+		connTLSConfig := tlsConfig
+		if !isTrustConfigured(appConfig) {
+			certPool, err := fetchCertificatesFromURL(ctx, address, tlsConfig)
+			if err != nil {
+				return nil, fmt.Errorf("could not fetch valid certificate: %w", err)
+			}
+
+			connTLSConfig = tlsConfig.Clone()
+			connTLSConfig.RootCAs = certPool
+		}
+
+		creds = credentials.NewTLS(connTLSConfig)
+	} else {
+		log.Warn().Msg("Using insecure connection")
+		creds = insecure.NewCredentials()
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithBlock(),
+		grpc.WithKeepaliveParams(
+			keepalive.ClientParameters{
+				Time:                appConfig.GrpcKeepalive,
+				Timeout:             appConfig.GrpcTimeout,
+				PermitWithoutStream: true,
+			}),
+	}
+
+	if appConfig.GrpcCompression {
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.UseCompressor(grpcgzip.Name)))
+	}
+
+	grpcAddress := fmt.Sprintf("%s%s", parsedUrl.Host, parsedUrl.Path)
+	log.Info().Str("address", grpcAddress).Msg("Dialing to address.")
+
+	// blocks until the connection is ready
+	dialCtx, cancel := context.WithTimeout(ctx, appConfig.GrpcTimeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(dialCtx, grpcAddress, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial gRPC: %w", err)
+	}
+
+	return conn, nil
+}
+
 func Init(grpcContext context.Context,
 	connParams *ConnectionParams,
 	appConfig *config.Configuration,
@@ -179,8 +345,6 @@ func Init(grpcContext context.Context,
 	loop.Ctx = metadata.AppendToOutgoingContext(loop.Ctx, contextMetadataKeyToken, connParams.token)
 
 	if grpcConn.Conn == nil {
-		var creds credentials.TransportCredentials
-
 		address := connParams.address
 		if !strings.HasPrefix(address, "http") {
 			address = fmt.Sprintf("https://%s", address)
@@ -191,71 +355,137 @@ func Init(grpcContext context.Context,
 			log.Panic().Err(err).Str("address", address).Msg("Failed to parse URL")
 		}
 
+		var tlsConfig *tls.Config
 		if parsedUrl.Scheme == "https" {
-			certPool, err := fetchCertificatesFromURL(loop.Ctx, address)
+			tlsConfig, err = newTLSConfig(appConfig)
 			if err != nil {
-				log.Panic().Err(err).Msg("Could not fetch valid certificate")
-			} else {
-				creds = credentials.NewClientTLSFromCert(certPool, "")
+				log.Panic().Err(err).Msg("Failed to load TLS configuration")
 			}
-		} else {
-			log.Warn().Msg("Using insecure connection")
-			creds = insecure.NewCredentials()
-		}
-
-		opts := []grpc.DialOption{
-			grpc.WithTransportCredentials(creds),
-			grpc.WithBlock(),
-			grpc.WithKeepaliveParams(
-				keepalive.ClientParameters{
-					Time:                appConfig.GrpcKeepalive,
-					Timeout:             appConfig.GrpcTimeout,
-					PermitWithoutStream: true,
-				}),
-		}
 
-		grpcAddress := fmt.Sprintf("%s%s", parsedUrl.Host, parsedUrl.Path)
-		log.Info().Str("address", grpcAddress).Msg("Dialing to address.")
-		conn, err := grpc.Dial(grpcAddress, opts...)
-		if err != nil {
-			log.Panic().Stack().Err(err).Msg("Failed to dial gRPC")
+			if !isTrustConfigured(appConfig) {
+				log.Warn().Msg("No CA file, system roots or certificate fingerprints are configured, trusting the certificate of the backend on first use")
+			}
 		}
 
+		// the backend might not be available yet, the agent retries until it is
+		retry := newBackoff(appConfig)
 		for {
-			state := conn.GetState()
-			if state != connectivity.Ready {
-				log.Debug().Msgf("Waiting for state to change: %d", state)
-				conn.WaitForStateChange(loop.Ctx, state)
-				log.Debug().Msgf("State Changed to: %d", conn.GetState())
-			} else {
+			conn, err := dialBackend(loop.Ctx, address, parsedUrl, tlsConfig, appConfig)
+			if err == nil {
+				grpcConn.Conn = conn
 				break
 			}
+
+			log.Error().Err(err).Msg("Failed to connect to the backend")
+			if !retry.wait(loop.Ctx, err) {
+				return
+			}
 		}
-		if err != nil {
-			log.Error().Stack().Err(err).Msg("gRPC connection error")
-		}
-		grpcConn.Conn = conn
 	}
 
 	loop.grpcLoop(connParams)
 }
 
-func (cl *ClientLoop) grpcProcessCommand(command *agent.AgentCommand) {
+// The result goes back on the connection that issued the command, a reconnect in the meantime drops it
+func (cl *ClientLoop) grpcProcessCommand(streamCtx context.Context, command *agent.AgentCommand) {
+	client := grpcConn.Client
+
+	go func() {
+		err := cl.executeCommand(command)
+		sendCommandResult(streamCtx, client, command.GetId(), err)
+	}()
+}
+
+func (cl *ClientLoop) executeCommand(command *agent.AgentCommand) error {
 	switch {
 	case command.GetContainerState() != nil:
-		go executeWatchContainerState(cl.Ctx, command.GetContainerState(), cl.WorkerFuncs.Watch)
+		return executeWatchContainerState(cl.Ctx, command.GetContainerState(), cl.WorkerFuncs.Watch)
 	case command.GetClose() != nil:
-		go cl.executeClose(command.GetClose())
+		return cl.executeClose(command.GetClose())
 	case command.GetContainerCommand() != nil:
-		go executeContainerCommand(cl.Ctx, command.GetContainerCommand(), cl.WorkerFuncs.ContainerCommand)
+		return executeContainerCommand(cl.Ctx, command.GetContainerCommand(), cl.WorkerFuncs.ContainerCommand)
+	case command.GetStackCommand() != nil:
+		return executeStackCommand(cl.Ctx, command.GetStackCommand(), cl.WorkerFuncs.StackCommand)
 	case command.GetContainerDelete() != nil:
-		go executeContainerDelete(cl.Ctx, command.GetContainerDelete(), cl.WorkerFuncs.ContaierDelete)
+		return executeContainerDelete(cl.Ctx, command.GetContainerDelete(), cl.WorkerFuncs.ContaierDelete)
 	case command.GetContainerLog() != nil:
-		go executeContainerLog(cl.Ctx, command.GetContainerLog(), cl.WorkerFuncs.ContainerLog)
+		return executeContainerLog(cl.Ctx, command.GetContainerLog(), cl.WorkerFuncs.ContainerLog, cl.AppConfig)
+	case command.GetContainerLogExport() != nil:
+		return executeContainerLogExport(cl.Ctx, command.GetContainerLogExport(), cl.WorkerFuncs.ContainerLogExport)
 	case command.GetContainerInspect() != nil:
-		go executeContainerInspect(cl.Ctx, command.GetContainerInspect(), cl.WorkerFuncs.ContainerInspect)
+		return executeContainerInspect(cl.Ctx, command.GetContainerInspect(), cl.WorkerFuncs.ContainerInspect)
+	case command.GetContainerExec() != nil:
+		return executeContainerExec(cl.Ctx, command.GetContainerExec(), cl.WorkerFuncs.ContainerExec)
+	case command.GetContainerStats() != nil:
+		return executeContainerStats(cl.Ctx, command.GetContainerStats(), cl.WorkerFuncs.ContainerStats)
+	case command.GetContainerEvents() != nil:
+		return executeContainerEvents(cl.Ctx, command.GetContainerEvents(), cl.WorkerFuncs.ContainerEvents)
+	case command.GetImageList() != nil:
+		return executeImageList(cl.Ctx, command.GetImageList(), cl.WorkerFuncs.ImageList)
+	case command.GetImageInspect() != nil:
+		return executeImageInspect(cl.Ctx, command.GetImageInspect(), cl.WorkerFuncs.ImageInspect)
+	case command.GetImageDelete() != nil:
+		return executeImageDelete(cl.Ctx, command.GetImageDelete(), cl.WorkerFuncs.ImageDelete)
+	case command.GetImagePull() != nil:
+		return executeImagePull(cl.Ctx, command.GetImagePull(), cl.WorkerFuncs.ImagePull)
+	case command.GetVolumeList() != nil:
+		return executeVolumeList(cl.Ctx, command.GetVolumeList(), cl.WorkerFuncs.VolumeList)
+	case command.GetVolumeInspect() != nil:
+		return executeVolumeInspect(cl.Ctx, command.GetVolumeInspect(), cl.WorkerFuncs.VolumeInspect)
+	case command.GetVolumeDelete() != nil:
+		return executeVolumeDelete(cl.Ctx, command.GetVolumeDelete(), cl.WorkerFuncs.VolumeDelete)
+	case command.GetNetworkList() != nil:
+		return executeNetworkList(cl.Ctx, command.GetNetworkList(), cl.WorkerFuncs.NetworkList)
+	case command.GetNetworkInspect() != nil:
+		return executeNetworkInspect(cl.Ctx, command.GetNetworkInspect(), cl.WorkerFuncs.NetworkInspect)
+	case command.GetNetworkDelete() != nil:
+		return executeNetworkDelete(cl.Ctx, command.GetNetworkDelete(), cl.WorkerFuncs.NetworkDelete)
+	case command.GetSystemPrune() != nil:
+		return executeSystemPrune(cl.Ctx, command.GetSystemPrune(), cl.WorkerFuncs.SystemPrune)
 	default:
 		log.Warn().Msg("Unknown agent command")
+		return ErrUnknownCommand
+	}
+}
+
+func commandErrorCode(err error) agent.ErrorCode {
+	if errors.Is(err, ErrNotImplemented) || errors.Is(err, ErrUnknownCommand) {
+		return agent.ErrorCode_NOT_IMPLEMENTED
+	}
+
+	return mapper.MapErrorCode(err)
+}
+
+// Error fields of response messages, empty when the command succeeded
+func responseError(err error) (string, agent.ErrorCode) {
+	if err == nil {
+		return "", agent.ErrorCode_ERROR_CODE_UNSPECIFIED
+	}
+
+	return err.Error(), commandErrorCode(err)
+}
+
+// Results are only sent for commands with an ID, so backends without correlation support are not flooded
+func sendCommandResult(streamCtx context.Context, client agent.AgentClient, id string, err error) {
+	if id == "" {
+		return
+	}
+
+	if streamCtx.Err() != nil {
+		log.Warn().Str("id", id).Msg("Connection closed, dropping command result")
+		return
+	}
+
+	result := &agent.CommandResultMessage{
+		Id:      id,
+		Success: err == nil,
+	}
+
+	result.Message, result.ErrorCode = responseError(err)
+
+	_, err = client.CommandResult(streamCtx, result)
+	if err != nil {
+		log.Error().Stack().Err(err).Str("id", id).Msg("Command result response error")
 	}
 }
 
@@ -264,23 +494,33 @@ func (cl *ClientLoop) grpcLoop(connParams *ConnectionParams) {
 	var err error
 	defer cl.cancel()
 	defer grpcConn.Conn.Close()
-	for {
-		if grpcConn.Client == nil {
-			client := agent.NewAgentClient(grpcConn.Conn)
-			grpcConn.SetClient(client)
 
+	retry := newBackoff(cl.AppConfig)
+	// reset after the first command, so a backend dropping every new stream does not get a retry storm
+	received := false
+
+	// the client is stateless over the shared connection, only the stream is renewed on reconnect
+	grpcConn.SetClient(agent.NewAgentClient(grpcConn.Conn))
+
+	for {
+		if stream == nil {
 			stream, err = grpcConn.Client.Connect(
 				cl.Ctx, &agent.AgentInfo{Id: connParams.nodeID, Version: version.BuildVersion()},
 				grpc.WaitForReady(true),
 			)
 			if err != nil {
 				log.Error().Stack().Err(err).Send()
-				time.Sleep(time.Second)
-				grpcConn.Client = nil
+				stream = nil
+				if !retry.wait(cl.Ctx, err) {
+					break
+				}
 				continue
 			}
 			log.Info().Msg("Stream connection is up")
 			health.SetHealthGRPCStatus(true)
+			received = false
+
+			go cl.executeNodeInfo(stream.Context())
 		}
 
 		command := new(agent.AgentCommand)
@@ -292,7 +532,7 @@ func (cl *ClientLoop) grpcLoop(connParams *ConnectionParams) {
 				break
 			}
 
-			grpcConn.Client = nil
+			stream = nil
 			health.SetHealthGRPCStatus(false)
 
 			if err == io.EOF {
@@ -301,11 +541,17 @@ func (cl *ClientLoop) grpcLoop(connParams *ConnectionParams) {
 				log.Error().Stack().Err(err).Msg("Cannot receive stream")
 			}
 
-			time.Sleep(cl.AppConfig.GrpcTimeout)
+			if received {
+				retry.reset()
+			}
+			if !retry.wait(cl.Ctx, err) {
+				break
+			}
 			continue
 		}
 
-		cl.grpcProcessCommand(command)
+		received = true
+		cl.grpcProcessCommand(stream.Context(), command)
 	}
 }
 
@@ -315,6 +561,8 @@ func streamContainerStatus(
 	req *agent.ContainerStateRequest,
 	eventsContext *ContainerWatchContext,
 ) {
+	sequence := uint64(0)
+
 	for {
 		select {
 		case <-streamCtx.Done():
@@ -323,8 +571,11 @@ func streamContainerStatus(
 			log.Error().Err(eventError).Msg("Container status watcher error")
 			return
 		case event := <-eventsContext.Events:
+			sequence++
 			err := stream.Send(&agent.ContainerStateListMessage{
-				Data: event,
+				Data:     event.Items,
+				Sequence: sequence,
+				Snapshot: event.Snapshot,
 			})
 			if err != nil {
 				log.Error().Err(err).Msg("Container status channel error")
@@ -346,10 +597,10 @@ func streamContainerStatus(
 	}
 }
 
-func executeWatchContainerState(ctx context.Context, req *agent.ContainerStateRequest, watchFn WatchFunc) {
+func executeWatchContainerState(ctx context.Context, req *agent.ContainerStateRequest, watchFn WatchFunc) error {
 	if watchFn == nil {
 		log.Error().Msg("Watch function not implemented")
-		return
+		return ErrNotImplemented
 	}
 
 	log.Info().Msg("Opening container status channel")
@@ -357,7 +608,7 @@ func executeWatchContainerState(ctx context.Context, req *agent.ContainerStateRe
 	stream, err := grpcConn.Client.ContainerState(ctx, grpc.WaitForReady(true))
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to open container status channel")
-		return
+		return err
 	}
 
 	defer func() {
@@ -372,7 +623,7 @@ func executeWatchContainerState(ctx context.Context, req *agent.ContainerStateRe
 	eventsContext, err := watchFn(streamCtx)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to open container status reader")
-		return
+		return err
 	}
 
 	// The channel consumer must run in a gofunc so RecvMsg can receive server side stream close events
@@ -390,12 +641,14 @@ func executeWatchContainerState(ctx context.Context, req *agent.ContainerStateRe
 	<-streamCtx.Done()
 
 	log.Info().Msg("Container status channel closed")
+
+	return nil
 }
 
-func executeContainerDelete(ctx context.Context, req *agent.ContainerDeleteRequest, deleteFn ContainerDeleteFunc) {
+func executeContainerDelete(ctx context.Context, req *agent.ContainerDeleteRequest, deleteFn ContainerDeleteFunc) error {
 	if deleteFn == nil {
 		log.Error().Msg("Delete function not implemented")
-		return
+		return ErrNotImplemented
 	}
 
 	log.Info().Str("name", req.Name).Msg("Deleting container")
@@ -403,15 +656,70 @@ func executeContainerDelete(ctx context.Context, req *agent.ContainerDeleteReque
 	err := deleteFn(ctx, req)
 	if err != nil {
 		log.Error().Stack().Err(err).Msg("Failed to delete multiple containers")
+		return err
+	}
+
+	return nil
+}
+
+// Returns false when the backend does not support node info, so it is not sent again on the same stream
+func sendNodeInfo(ctx context.Context, client agent.AgentClient, nodeInfoFunc NodeInfoFunc) bool {
+	info, err := nodeInfoFunc(ctx)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Failed to get node info")
+		return true
+	}
+
+	_, err = client.NodeInfo(ctx, info)
+	if status.Code(err) == codes.Unimplemented {
+		log.Info().Msg("Backend does not support node info, not sending it")
+		return false
+	}
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Node info response error")
+	}
+
+	return true
+}
+
+// The node info is sent when the connection is up, then periodically until the connection stream closes
+func (cl *ClientLoop) executeNodeInfo(streamCtx context.Context) {
+	nodeInfoFunc := cl.WorkerFuncs.NodeInfo
+
+	if nodeInfoFunc == nil {
+		log.Error().Msg("Node info function not implemented")
+		return
+	}
+
+	log.Debug().Msg("Sending node info")
+
+	client := grpcConn.Client
+
+	if !sendNodeInfo(streamCtx, client, nodeInfoFunc) || cl.AppConfig.NodeInfoInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(cl.AppConfig.NodeInfoInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-streamCtx.Done():
+			return
+		case <-ticker.C:
+			if !sendNodeInfo(streamCtx, client, nodeInfoFunc) {
+				return
+			}
+		}
 	}
 }
 
-func (cl *ClientLoop) executeClose(command *agent.CloseConnectionRequest) {
+func (cl *ClientLoop) executeClose(command *agent.CloseConnectionRequest) error {
 	closeFunc := cl.WorkerFuncs.Close
 
 	if closeFunc == nil {
 		log.Error().Msg("Close function not implemented")
-		return
+		return ErrNotImplemented
 	}
 
 	log.Debug().Str("reason", agent.CloseReason_name[int32(command.GetReason())]).Msg("gRPC connection remotely closed")
@@ -419,34 +727,126 @@ func (cl *ClientLoop) executeClose(command *agent.CloseConnectionRequest) {
 	err := closeFunc(cl.Ctx, command.Reason)
 	if err != nil {
 		log.Error().Stack().Err(err).Msg("Close handler error")
+		return err
 	}
+
+	return nil
 }
 
-func executeContainerCommand(ctx context.Context, command *agent.ContainerCommandRequest, containerCommandFunc ContainerCommandFunc) {
+func executeContainerCommand(ctx context.Context, command *agent.ContainerCommandRequest, containerCommandFunc ContainerCommandFunc) error {
 	if containerCommandFunc == nil {
 		log.Error().Msg("Container command function not implemented")
-		return
+		return ErrNotImplemented
 	}
 
 	log.Info().
 		Str("operation", command.Operation.String()).
 		Str("name", command.Name).
+		Int32("timeout", command.GetTimeout()).
+		Str("signal", command.GetSignal()).
 		Msg("Executing")
 
 	err := containerCommandFunc(ctx, command)
 	if err != nil {
 		log.Error().Stack().Err(err).Msg("Container Command error")
+		return err
+	}
+
+	return nil
+}
+
+func executeStackCommand(ctx context.Context, command *agent.StackCommandRequest, stackCommandFunc StackCommandFunc) error {
+	if stackCommandFunc == nil {
+		log.Error().Msg("Stack command function not implemented")
+		return ErrNotImplemented
 	}
+
+	log.Info().
+		Str("operation", command.Operation.String()).
+		Str("project", command.Project).
+		Int32("timeout", command.GetTimeout()).
+		Str("signal", command.GetSignal()).
+		Msg("Executing on stack")
+
+	err := stackCommandFunc(ctx, command)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Stack command error")
+		return err
+	}
+
+	return nil
 }
 
+// Without batching every line is sent as a ContainerLogMessage as soon as it is read
 func streamContainerLog(reader ContainerLogReader,
-	client agent.Agent_ContainerLogClient,
+	client grpc.ClientStream,
 	name string,
 	streaming bool,
+	batching bool,
 	logContext *ContainerLogContext,
+	appConfig *config.Configuration,
 ) {
+	batch := &agent.ContainerLogBatchMessage{}
+	batchSize := 0
+
+	var flushTimer *time.Timer
+	var flush <-chan time.Time
+
+	sendBatch := func() error {
+		if flushTimer != nil {
+			flushTimer.Stop()
+			flushTimer = nil
+			flush = nil
+		}
+
+		if len(batch.Logs) < 1 {
+			return nil
+		}
+
+		var err error
+		if batching {
+			err = client.SendMsg(batch)
+		} else {
+			for _, it := range batch.Logs {
+				err = client.SendMsg(it)
+				if err != nil {
+					break
+				}
+			}
+		}
+
+		batch = &agent.ContainerLogBatchMessage{}
+		batchSize = 0
+
+		return err
+	}
+
+	defer func() {
+		if client.Context().Err() != nil {
+			return
+		}
+
+		err := sendBatch()
+		if err != nil {
+			log.Error().Err(err).Stack().Str("name", name).Msg("Container log channel error")
+		}
+	}()
+
 	for {
-		event := <-reader.Next()
+		var event ContainerLogEvent
+		select {
+		case <-client.Context().Done():
+			return
+		case <-flush:
+			err := sendBatch()
+			if err != nil {
+				log.Error().Err(err).Stack().Str("name", name).Msg("Container log channel error")
+				return
+			}
+			continue
+		case event = <-reader.Next():
+		}
+
 		if event.Error != nil {
 			if event.Error == io.EOF && !streaming {
 				log.Trace().Str("name", name).Msg("Container log finished non streaming (EOF)")
@@ -461,7 +861,12 @@ func streamContainerLog(reader ContainerLogReader,
 			log.Error().Err(event.Error).Stack().Str("name", name).Msg("Container log reader error")
 
 			if client.Context().Err() == nil {
-				err := client.CloseSend()
+				err := sendBatch()
+				if err != nil {
+					log.Error().Err(err).Stack().Str("name", name).Msg("Container log channel error")
+				}
+
+				err = client.CloseSend()
 				if err != nil {
 					log.Error().Err(err).Stack().Str("name", name).Msg("Failed to close client")
 				}
@@ -471,12 +876,32 @@ func streamContainerLog(reader ContainerLogReader,
 		}
 
 		if logContext.Echo {
-			log.Debug().Str("name", name).Str("log", event.Message).Msg("Container log")
+			log.Debug().Str("name", name).Stringer("stream", event.Stream).Str("log", event.Message).Msg("Container log")
 		}
 
-		err := client.Send(&agent.ContainerLogMessage{
-			Log: event.Message,
-		})
+		logMessage := &agent.ContainerLogMessage{
+			Log:        event.Message,
+			Stream:     event.Stream,
+			Structured: event.Structured,
+			Offset:     event.Offset,
+			Container:  event.Container,
+		}
+		if !event.Timestamp.IsZero() {
+			logMessage.Timestamp = timestamppb.New(event.Timestamp)
+		}
+
+		batch.Logs = append(batch.Logs, logMessage)
+		batchSize += proto.Size(logMessage)
+
+		if batching && batchSize < appConfig.LogBatchSize && appConfig.LogBatchWindow > 0 {
+			if flushTimer == nil {
+				flushTimer = time.NewTimer(appConfig.LogBatchWindow)
+				flush = flushTimer.C
+			}
+			continue
+		}
+
+		err := sendBatch()
 		if err != nil {
 			log.Error().Err(err).Stack().Str("name", name).Msg("Container log channel error")
 			break
@@ -484,23 +909,49 @@ func streamContainerLog(reader ContainerLogReader,
 	}
 }
 
-func executeContainerLog(ctx context.Context, command *agent.ContainerLogRequest, logFunc ContainerLogFunc) {
+func executeContainerLog(ctx context.Context,
+	command *agent.ContainerLogRequest,
+	logFunc ContainerLogFunc,
+	appConfig *config.Configuration,
+) error {
 	if logFunc == nil {
 		log.Error().Msg("Container log function not implemented")
-		return
+		return ErrNotImplemented
 	}
 
 	name := command.Name
 
-	log.Debug().Str("name", name).Uint32("tail", command.GetTail()).
-		Bool("stream", command.GetStreaming()).Msg("Getting container logs")
+	logEvent := log.Debug().Str("name", name).Uint32("tail", command.GetTail()).
+		Bool("stream", command.GetStreaming()).Stringer("output", command.GetStream()).Bool("batch", command.GetBatch())
+	if command.Since != nil {
+		logEvent = logEvent.Time("since", command.Since.AsTime())
+	}
+	if command.Until != nil {
+		logEvent = logEvent.Time("until", command.Until.AsTime())
+	}
+	if command.Filter != nil {
+		logEvent = logEvent.Str("filter", command.Filter.Expression)
+	}
+	if command.ResumeFrom != nil {
+		logEvent = logEvent.Uint64("resumeFrom", *command.ResumeFrom)
+	}
+	if len(command.Names) > 0 || len(command.Labels) > 0 {
+		logEvent = logEvent.Strs("names", command.Names).Strs("labels", command.Labels)
+	}
+	logEvent.Msg("Getting container logs")
 
 	streamCtx := metadata.AppendToOutgoingContext(ctx, "lens-container-name", name)
 
-	stream, err := grpcConn.Client.ContainerLog(streamCtx, grpc.WaitForReady(true))
+	var stream grpc.ClientStream
+	var err error
+	if command.GetBatch() {
+		stream, err = grpcConn.Client.ContainerLogBatch(streamCtx, grpc.WaitForReady(true))
+	} else {
+		stream, err = grpcConn.Client.ContainerLog(streamCtx, grpc.WaitForReady(true))
+	}
 	if err != nil {
 		log.Error().Err(err).Str("name", name).Msg("Failed to open container log channel")
-		return
+		return err
 	}
 
 	defer func() {
@@ -515,7 +966,7 @@ func executeContainerLog(ctx context.Context, command *agent.ContainerLogRequest
 	logContext, err := logFunc(streamCtx, command)
 	if err != nil {
 		log.Error().Err(err).Str("name", name).Msg("Failed to open container log reader")
-		return
+		return err
 	}
 
 	reader := logContext.Reader
@@ -527,7 +978,7 @@ func executeContainerLog(ctx context.Context, command *agent.ContainerLogRequest
 		}
 	}()
 
-	go streamContainerLog(reader, stream, name, command.GetStreaming(), logContext)
+	go streamContainerLog(reader, stream, name, command.GetStreaming(), command.GetBatch(), logContext, appConfig)
 
 	for {
 		var msg interface{}
@@ -540,33 +991,889 @@ func executeContainerLog(ctx context.Context, command *agent.ContainerLogRequest
 	<-streamCtx.Done()
 
 	log.Trace().Str("name", name).Msg("Container log exited")
+
+	return nil
 }
 
-func executeContainerInspect(ctx context.Context, command *agent.ContainerInspectRequest, inspectFunc ContainerInspectFunc) {
-	if inspectFunc == nil {
-		log.Error().Msg("Container inspect function not implemented")
-		return
+const logExportChunkSize = 64 * 1024
+
+// logExportWriter sends the compressed log in fixed size chunks
+type logExportWriter struct {
+	stream  agent.Agent_ContainerLogExportClient
+	buffer  []byte
+	written uint64
+}
+
+func (writer *logExportWriter) Write(data []byte) (int, error) {
+	written := 0
+	for len(data) > 0 {
+		count := copy(writer.buffer[len(writer.buffer):cap(writer.buffer)], data)
+		writer.buffer = writer.buffer[:len(writer.buffer)+count]
+		data = data[count:]
+		written += count
+
+		if len(writer.buffer) == cap(writer.buffer) {
+			err := writer.Flush()
+			if err != nil {
+				return written, err
+			}
+		}
+	}
+
+	return written, nil
+}
+
+func (writer *logExportWriter) Flush() error {
+	if len(writer.buffer) < 1 {
+		return nil
+	}
+
+	// the message is serialized by Send, so the buffer can be reused
+	err := writer.stream.Send(&agent.ContainerLogExportMessage{
+		Message: &agent.ContainerLogExportMessage_Chunk{
+			Chunk: writer.buffer,
+		},
+	})
+	writer.written += uint64(len(writer.buffer))
+	writer.buffer = writer.buffer[:0]
+
+	return err
+}
+
+func exportContainerLog(ctx context.Context,
+	command *agent.ContainerLogExportRequest,
+	exportFunc ContainerLogExportFunc,
+	stream agent.Agent_ContainerLogExportClient,
+) (*agent.ContainerLogExportSummary, error) {
+	summary := &agent.ContainerLogExportSummary{}
+
+	reader, err := exportFunc(ctx, command)
+	if err != nil {
+		return summary, err
+	}
+
+	defer func() {
+		err := reader.Close()
+		if err != nil {
+			log.Error().Err(err).Str("name", command.Name).Msg("Failed to close container log export reader")
+		}
+	}()
+
+	chunks := &logExportWriter{
+		stream: stream,
+		buffer: make([]byte, 0, logExportChunkSize),
+	}
+	checksum := sha256.New()
+	compressor := gzip.NewWriter(io.MultiWriter(chunks, checksum))
+
+	size, err := io.Copy(compressor, reader)
+	summary.Size = uint64(size)
+	if err != nil {
+		return summary, err
+	}
+
+	err = compressor.Close()
+	if err != nil {
+		return summary, err
+	}
+
+	err = chunks.Flush()
+	if err != nil {
+		return summary, err
+	}
+
+	summary.Sha256 = hex.EncodeToString(checksum.Sum(nil))
+	summary.CompressedSize = chunks.written
+
+	return summary, nil
+}
+
+func executeContainerLogExport(ctx context.Context, command *agent.ContainerLogExportRequest, exportFunc ContainerLogExportFunc) error {
+	if exportFunc == nil {
+		log.Error().Msg("Container log export function not implemented")
+		return ErrNotImplemented
 	}
 
 	name := command.Name
 
-	log.Info().Str("name", name).Msg("Getting container inspection")
+	log.Info().Str("name", name).Msg("Exporting container log")
 
-	inspection, err := inspectFunc(ctx, command)
+	streamCtx := metadata.AppendToOutgoingContext(ctx, "lens-container-name", name)
+
+	stream, err := grpcConn.Client.ContainerLogExport(streamCtx, grpc.WaitForReady(true))
 	if err != nil {
-		log.Error().Stack().Err(err).Msg("Failed to inspect container")
+		log.Error().Err(err).Str("name", name).Msg("Failed to open container log export channel")
+		return err
 	}
 
-	resp := &agent.ContainerInspectMessage{
-		Name:       name,
-		Inspection: inspection,
+	summary, exportErr := exportContainerLog(stream.Context(), command, exportFunc, stream)
+	if exportErr != nil {
+		log.Error().Stack().Err(exportErr).Str("name", name).Msg("Failed to export container log")
 	}
+	summary.Error, summary.ErrorCode = responseError(exportErr)
 
-	_, err = grpcConn.Client.ContainerInspect(ctx, resp)
+	err = stream.Send(&agent.ContainerLogExportMessage{
+		Message: &agent.ContainerLogExportMessage_Summary{
+			Summary: summary,
+		},
+	})
 	if err != nil {
-		log.Error().Stack().Err(err).Msg("Container inspection response error")
-		return
+		log.Error().Err(err).Stack().Str("name", name).Msg("Container log export channel error")
+		return err
+	}
+
+	_, err = stream.CloseAndRecv()
+	if err != nil {
+		log.Error().Err(err).Stack().Str("name", name).Msg("Failed to close container log export stream")
+		return err
 	}
+
+	log.Info().Str("name", name).Uint64("size", summary.Size).Uint64("compressedSize", summary.CompressedSize).
+		Msg("Container log exported")
+
+	return exportErr
+}
+
+func executeContainerInspect(ctx context.Context, command *agent.ContainerInspectRequest, inspectFunc ContainerInspectFunc) error {
+	if inspectFunc == nil {
+		log.Error().Msg("Container inspect function not implemented")
+		return ErrNotImplemented
+	}
+
+	name := command.Name
+
+	log.Info().Str("name", name).Msg("Getting container inspection")
+
+	inspection, inspectErr := inspectFunc(ctx, command)
+	if inspectErr != nil {
+		log.Error().Stack().Err(inspectErr).Msg("Failed to inspect container")
+	}
+
+	resp := &agent.ContainerInspectMessage{
+		Name:       name,
+		Inspection: inspection,
+	}
+	resp.Error, resp.ErrorCode = responseError(inspectErr)
+
+	_, err := grpcConn.Client.ContainerInspect(ctx, resp)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Container inspection response error")
+		return err
+	}
+
+	return inspectErr
+}
+
+func streamContainerExec(session ContainerExecSession,
+	client agent.Agent_ContainerExecClient,
+	name string,
+) {
+	for {
+		var event ContainerExecEvent
+		select {
+		case <-client.Context().Done():
+			return
+		case event = <-session.Next():
+		}
+
+		if event.Error != nil {
+			if event.Error == context.Canceled {
+				log.Trace().Str("name", name).Msg("Container exec finished context cancel (server close)")
+				break
+			}
+
+			if event.Error != io.EOF {
+				log.Error().Err(event.Error).Stack().Str("name", name).Msg("Container exec reader error")
+			}
+
+			exitCode, err := session.ExitCode(client.Context())
+			if err != nil {
+				log.Error().Err(err).Stack().Str("name", name).Msg("Failed to get container exec exit code")
+			} else {
+				err = client.Send(&agent.ContainerExecMessage{
+					Message: &agent.ContainerExecMessage_Exit{
+						Exit: &agent.ContainerExecExit{
+							ExitCode: exitCode,
+						},
+					},
+				})
+				if err != nil {
+					log.Error().Err(err).Stack().Str("name", name).Msg("Container exec channel error")
+				}
+			}
+
+			if client.Context().Err() == nil {
+				err = client.CloseSend()
+				if err != nil {
+					log.Error().Err(err).Stack().Str("name", name).Msg("Failed to close client")
+				}
+			}
+
+			break
+		}
+
+		err := client.Send(&agent.ContainerExecMessage{
+			Message: &agent.ContainerExecMessage_Output{
+				Output: &agent.ContainerExecOutput{
+					Stream: event.Stream,
+					Data:   event.Data,
+				},
+			},
+		})
+		if err != nil {
+			log.Error().Err(err).Stack().Str("name", name).Msg("Container exec channel error")
+			break
+		}
+	}
+}
+
+func processContainerExecInput(ctx context.Context, session ContainerExecSession, input *agent.ContainerExecInput) error {
+	switch {
+	case input.GetResize() != nil:
+		resize := input.GetResize()
+		return session.Resize(ctx, resize.Width, resize.Height)
+	case input.GetCloseStdin():
+		return session.CloseWrite()
+	default:
+		return session.Write(input.GetStdin())
+	}
+}
+
+func executeContainerExec(ctx context.Context, command *agent.ContainerExecRequest, execFunc ContainerExecFunc) error {
+	if execFunc == nil {
+		log.Error().Msg("Container exec function not implemented")
+		return ErrNotImplemented
+	}
+
+	name := command.Name
+
+	log.Info().Str("name", name).Str("id", command.Id).Strs("command", command.Command).
+		Bool("tty", command.Tty).Msg("Executing in container")
+
+	streamCtx := metadata.AppendToOutgoingContext(ctx, "lens-container-name", name, "lens-exec-id", command.Id)
+
+	stream, err := grpcConn.Client.ContainerExec(streamCtx, grpc.WaitForReady(true))
+	if err != nil {
+		log.Error().Err(err).Str("name", name).Msg("Failed to open container exec channel")
+		return err
+	}
+
+	defer func() {
+		err = stream.CloseSend()
+		if err != nil {
+			log.Error().Err(err).Stack().Str("name", name).Msg("Failed to close container exec stream")
+		}
+	}()
+
+	streamCtx = stream.Context()
+
+	session, err := execFunc(streamCtx, command)
+	if err != nil {
+		log.Error().Err(err).Str("name", name).Msg("Failed to start container exec")
+		return err
+	}
+
+	defer func() {
+		err = session.Close()
+		if err != nil {
+			log.Error().Err(err).Str("name", name).Msg("Failed to close container exec session")
+		}
+	}()
+
+	go streamContainerExec(session, stream, name)
+
+	// Recv must be called in order to get the input and an error if the server closes the stream
+	for {
+		input, err := stream.Recv()
+		if err != nil {
+			break
+		}
+
+		err = processContainerExecInput(streamCtx, session, input)
+		if err != nil {
+			log.Error().Err(err).Str("name", name).Msg("Failed to process container exec input")
+		}
+	}
+
+	<-streamCtx.Done()
+
+	log.Trace().Str("name", name).Msg("Container exec exited")
+
+	return nil
+}
+
+func streamContainerStats(reader ContainerStatsReader,
+	client agent.Agent_ContainerStatsClient,
+	name string,
+) {
+	for {
+		var event ContainerStatsEvent
+		select {
+		case <-client.Context().Done():
+			return
+		case event = <-reader.Next():
+		}
+
+		if event.Error != nil {
+			if event.Error == context.Canceled {
+				log.Trace().Str("name", name).Msg("Container stats finished context cancel (server close)")
+				break
+			}
+
+			log.Error().Err(event.Error).Stack().Str("name", name).Msg("Container stats reader error")
+
+			if client.Context().Err() == nil {
+				err := client.CloseSend()
+				if err != nil {
+					log.Error().Err(err).Stack().Str("name", name).Msg("Failed to close client")
+				}
+			}
+
+			break
+		}
+
+		err := client.Send(event.Stats)
+		if err != nil {
+			log.Error().Err(err).Stack().Str("name", name).Msg("Container stats channel error")
+			break
+		}
+	}
+}
+
+func executeContainerStats(ctx context.Context, command *agent.ContainerStatsRequest, statsFunc ContainerStatsFunc) error {
+	if statsFunc == nil {
+		log.Error().Msg("Container stats function not implemented")
+		return ErrNotImplemented
+	}
+
+	name := command.Name
+
+	log.Debug().Str("name", name).Uint32("interval", command.GetInterval()).Msg("Getting container stats")
+
+	streamCtx := metadata.AppendToOutgoingContext(ctx, "lens-container-name", name)
+
+	stream, err := grpcConn.Client.ContainerStats(streamCtx, grpc.WaitForReady(true))
+	if err != nil {
+		log.Error().Err(err).Str("name", name).Msg("Failed to open container stats channel")
+		return err
+	}
+
+	defer func() {
+		err = stream.CloseSend()
+		if err != nil {
+			log.Error().Err(err).Stack().Str("name", name).Msg("Failed to close container stats stream")
+		}
+	}()
+
+	streamCtx = stream.Context()
+
+	reader, err := statsFunc(streamCtx, command)
+	if err != nil {
+		log.Error().Err(err).Str("name", name).Msg("Failed to open container stats reader")
+		return err
+	}
+
+	defer func() {
+		err = reader.Close()
+		if err != nil {
+			log.Error().Err(err).Str("name", name).Msg("Failed to close container stats reader")
+		}
+	}()
+
+	go streamContainerStats(reader, stream, name)
+
+	for {
+		var msg interface{}
+		err := stream.RecvMsg(&msg)
+		if err != nil {
+			break
+		}
+	}
+
+	<-streamCtx.Done()
+
+	log.Trace().Str("name", name).Msg("Container stats exited")
+
+	return nil
+}
+
+func streamContainerEvents(reader ContainerEventsReader, client agent.Agent_ContainerEventsClient) {
+	for {
+		var event ContainerEventsEvent
+		select {
+		case <-client.Context().Done():
+			return
+		case event = <-reader.Next():
+		}
+
+		if event.Error != nil {
+			if event.Error == context.Canceled {
+				log.Trace().Msg("Container events finished context cancel (server close)")
+				break
+			}
+
+			if errors.Is(event.Error, io.EOF) {
+				log.Debug().Msg("Container events reached the end of the requested range")
+			} else {
+				log.Error().Err(event.Error).Stack().Msg("Container events reader error")
+			}
+
+			if client.Context().Err() == nil {
+				err := client.CloseSend()
+				if err != nil {
+					log.Error().Err(err).Stack().Msg("Failed to close client")
+				}
+			}
+
+			break
+		}
+
+		err := client.Send(event.Event)
+		if err != nil {
+			log.Error().Err(err).Stack().Msg("Container events channel error")
+			break
+		}
+	}
+}
+
+func executeContainerEvents(ctx context.Context, command *agent.ContainerEventsRequest, eventsFunc ContainerEventsFunc) error {
+	if eventsFunc == nil {
+		log.Error().Msg("Container events function not implemented")
+		return ErrNotImplemented
+	}
+
+	logEvent := log.Debug().Strs("types", command.Types)
+	if command.Since != nil {
+		logEvent = logEvent.Time("since", command.Since.AsTime())
+	}
+	if command.Until != nil {
+		logEvent = logEvent.Time("until", command.Until.AsTime())
+	}
+	if command.Container != nil {
+		logEvent = logEvent.Str("container", command.GetContainer())
+	}
+	logEvent.Msg("Getting container events")
+
+	stream, err := grpcConn.Client.ContainerEvents(ctx, grpc.WaitForReady(true))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to open container events channel")
+		return err
+	}
+
+	defer func() {
+		err = stream.CloseSend()
+		if err != nil {
+			log.Error().Err(err).Stack().Msg("Failed to close container events stream")
+		}
+	}()
+
+	streamCtx := stream.Context()
+
+	reader, err := eventsFunc(streamCtx, command)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to open container events reader")
+		return err
+	}
+
+	defer func() {
+		err = reader.Close()
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to close container events reader")
+		}
+	}()
+
+	go streamContainerEvents(reader, stream)
+
+	for {
+		var msg interface{}
+		err := stream.RecvMsg(&msg)
+		if err != nil {
+			break
+		}
+	}
+
+	<-streamCtx.Done()
+
+	log.Trace().Msg("Container events exited")
+
+	return nil
+}
+
+func executeImageList(ctx context.Context, command *agent.ImageListRequest, listFunc ImageListFunc) error {
+	if listFunc == nil {
+		log.Error().Msg("Image list function not implemented")
+		return ErrNotImplemented
+	}
+
+	log.Info().Bool("all", command.All).Msg("Listing images")
+
+	images, listErr := listFunc(ctx, command)
+	if listErr != nil {
+		log.Error().Stack().Err(listErr).Msg("Failed to list images")
+	}
+
+	resp := &agent.ImageListMessage{
+		Data: images,
+	}
+	resp.Error, resp.ErrorCode = responseError(listErr)
+
+	_, err := grpcConn.Client.ImageList(ctx, resp)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Image list response error")
+		return err
+	}
+
+	return listErr
+}
+
+func executeImageInspect(ctx context.Context, command *agent.ImageInspectRequest, inspectFunc ImageInspectFunc) error {
+	if inspectFunc == nil {
+		log.Error().Msg("Image inspect function not implemented")
+		return ErrNotImplemented
+	}
+
+	id := command.Id
+
+	log.Info().Str("id", id).Msg("Getting image inspection")
+
+	inspection, inspectErr := inspectFunc(ctx, command)
+	if inspectErr != nil {
+		log.Error().Stack().Err(inspectErr).Msg("Failed to inspect image")
+	}
+
+	resp := &agent.ImageInspectMessage{
+		Id:         id,
+		Inspection: inspection,
+	}
+	resp.Error, resp.ErrorCode = responseError(inspectErr)
+
+	_, err := grpcConn.Client.ImageInspect(ctx, resp)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Image inspection response error")
+		return err
+	}
+
+	return inspectErr
+}
+
+func executeImageDelete(ctx context.Context, command *agent.ImageDeleteRequest, deleteFunc ImageDeleteFunc) error {
+	if deleteFunc == nil {
+		log.Error().Msg("Image delete function not implemented")
+		return ErrNotImplemented
+	}
+
+	log.Info().Str("id", command.Id).Bool("force", command.Force).Msg("Deleting image")
+
+	err := deleteFunc(ctx, command)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Failed to delete image")
+		return err
+	}
+
+	return nil
+}
+
+// The failure of the pull is written to the result, the runtime reports it in the progress messages
+func streamImagePull(reader ImagePullReader,
+	client agent.Agent_ImagePullClient,
+	image string,
+	result chan<- error,
+) {
+	var pullErr error
+	defer func() {
+		result <- pullErr
+	}()
+
+	for {
+		var event ImagePullEvent
+		select {
+		case <-client.Context().Done():
+			return
+		case event = <-reader.Next():
+		}
+
+		if event.Error != nil {
+			if event.Error == io.EOF {
+				log.Info().Str("image", image).Msg("Image pull finished")
+			} else if event.Error != context.Canceled {
+				log.Error().Err(event.Error).Stack().Str("image", image).Msg("Image pull reader error")
+				pullErr = event.Error
+
+				// the backend learns about the failure from the stream, not only from the closing
+				failure := &agent.ImagePullMessage{}
+				failure.Error, failure.ErrorCode = responseError(pullErr)
+
+				err := client.Send(failure)
+				if err != nil {
+					log.Error().Err(err).Stack().Str("image", image).Msg("Image pull channel error")
+				}
+			}
+
+			if client.Context().Err() == nil {
+				err := client.CloseSend()
+				if err != nil {
+					log.Error().Err(err).Stack().Str("image", image).Msg("Failed to close client")
+				}
+			}
+
+			break
+		}
+
+		if event.Progress.GetError() != "" {
+			pullErr = errors.New(event.Progress.GetError())
+		}
+
+		err := client.Send(event.Progress)
+		if err != nil {
+			log.Error().Err(err).Stack().Str("image", image).Msg("Image pull channel error")
+			break
+		}
+	}
+}
+
+func executeImagePull(ctx context.Context, command *agent.ImagePullRequest, pullFunc ImagePullFunc) error {
+	if pullFunc == nil {
+		log.Error().Msg("Image pull function not implemented")
+		return ErrNotImplemented
+	}
+
+	image := command.Image
+
+	log.Info().Str("image", image).Msg("Pulling image")
+
+	streamCtx := metadata.AppendToOutgoingContext(ctx, "lens-image-name", image)
+
+	stream, err := grpcConn.Client.ImagePull(streamCtx, grpc.WaitForReady(true))
+	if err != nil {
+		log.Error().Err(err).Str("image", image).Msg("Failed to open image pull channel")
+		return err
+	}
+
+	defer func() {
+		err = stream.CloseSend()
+		if err != nil {
+			log.Error().Err(err).Stack().Str("image", image).Msg("Failed to close image pull stream")
+		}
+	}()
+
+	streamCtx = stream.Context()
+
+	reader, err := pullFunc(streamCtx, command)
+	if err != nil {
+		log.Error().Err(err).Str("image", image).Msg("Failed to pull image")
+		return err
+	}
+
+	defer func() {
+		err = reader.Close()
+		if err != nil {
+			log.Error().Err(err).Str("image", image).Msg("Failed to close image pull reader")
+		}
+	}()
+
+	result := make(chan error, 1)
+	go streamImagePull(reader, stream, image, result)
+
+	for {
+		var msg interface{}
+		err := stream.RecvMsg(&msg)
+		if err != nil {
+			break
+		}
+	}
+
+	<-streamCtx.Done()
+
+	log.Trace().Str("image", image).Msg("Image pull exited")
+
+	return <-result
+}
+
+func executeVolumeList(ctx context.Context, command *agent.VolumeListRequest, listFunc VolumeListFunc) error {
+	if listFunc == nil {
+		log.Error().Msg("Volume list function not implemented")
+		return ErrNotImplemented
+	}
+
+	log.Info().Msg("Listing volumes")
+
+	volumes, listErr := listFunc(ctx, command)
+	if listErr != nil {
+		log.Error().Stack().Err(listErr).Msg("Failed to list volumes")
+	}
+
+	resp := &agent.VolumeListMessage{
+		Data: volumes,
+	}
+	resp.Error, resp.ErrorCode = responseError(listErr)
+
+	_, err := grpcConn.Client.VolumeList(ctx, resp)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Volume list response error")
+		return err
+	}
+
+	return listErr
+}
+
+func executeVolumeInspect(ctx context.Context, command *agent.VolumeInspectRequest, inspectFunc VolumeInspectFunc) error {
+	if inspectFunc == nil {
+		log.Error().Msg("Volume inspect function not implemented")
+		return ErrNotImplemented
+	}
+
+	name := command.Name
+
+	log.Info().Str("name", name).Msg("Getting volume inspection")
+
+	inspection, inspectErr := inspectFunc(ctx, command)
+	if inspectErr != nil {
+		log.Error().Stack().Err(inspectErr).Msg("Failed to inspect volume")
+	}
+
+	resp := &agent.VolumeInspectMessage{
+		Name:       name,
+		Inspection: inspection,
+	}
+	resp.Error, resp.ErrorCode = responseError(inspectErr)
+
+	_, err := grpcConn.Client.VolumeInspect(ctx, resp)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Volume inspection response error")
+		return err
+	}
+
+	return inspectErr
+}
+
+func executeVolumeDelete(ctx context.Context, command *agent.VolumeDeleteRequest, deleteFunc VolumeDeleteFunc) error {
+	if deleteFunc == nil {
+		log.Error().Msg("Volume delete function not implemented")
+		return ErrNotImplemented
+	}
+
+	log.Info().Str("name", command.Name).Bool("force", command.Force).Msg("Deleting volume")
+
+	err := deleteFunc(ctx, command)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Failed to delete volume")
+		return err
+	}
+
+	return nil
+}
+
+func executeNetworkList(ctx context.Context, command *agent.NetworkListRequest, listFunc NetworkListFunc) error {
+	if listFunc == nil {
+		log.Error().Msg("Network list function not implemented")
+		return ErrNotImplemented
+	}
+
+	log.Info().Msg("Listing networks")
+
+	networks, listErr := listFunc(ctx, command)
+	if listErr != nil {
+		log.Error().Stack().Err(listErr).Msg("Failed to list networks")
+	}
+
+	resp := &agent.NetworkListMessage{
+		Data: networks,
+	}
+	resp.Error, resp.ErrorCode = responseError(listErr)
+
+	_, err := grpcConn.Client.NetworkList(ctx, resp)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Network list response error")
+		return err
+	}
+
+	return listErr
+}
+
+func executeNetworkInspect(ctx context.Context, command *agent.NetworkInspectRequest, inspectFunc NetworkInspectFunc) error {
+	if inspectFunc == nil {
+		log.Error().Msg("Network inspect function not implemented")
+		return ErrNotImplemented
+	}
+
+	id := command.Id
+
+	log.Info().Str("id", id).Msg("Getting network inspection")
+
+	inspection, inspectErr := inspectFunc(ctx, command)
+	if inspectErr != nil {
+		log.Error().Stack().Err(inspectErr).Msg("Failed to inspect network")
+	}
+
+	resp := &agent.NetworkInspectMessage{
+		Id:         id,
+		Inspection: inspection,
+	}
+	resp.Error, resp.ErrorCode = responseError(inspectErr)
+
+	_, err := grpcConn.Client.NetworkInspect(ctx, resp)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Network inspection response error")
+		return err
+	}
+
+	return inspectErr
+}
+
+func executeNetworkDelete(ctx context.Context, command *agent.NetworkDeleteRequest, deleteFunc NetworkDeleteFunc) error {
+	if deleteFunc == nil {
+		log.Error().Msg("Network delete function not implemented")
+		return ErrNotImplemented
+	}
+
+	log.Info().Str("id", command.Id).Msg("Deleting network")
+
+	err := deleteFunc(ctx, command)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("Failed to delete network")
+		return err
+	}
+
+	return nil
+}
+
+func executeSystemPrune(ctx context.Context, command *agent.SystemPruneRequest, pruneFunc SystemPruneFunc) error {
+	if pruneFunc == nil {
+		log.Error().Msg("System prune function not implemented")
+		return ErrNotImplemented
+	}
+
+	log.Info().
+		Bool("containers", command.Containers).
+		Bool("danglingImages", command.DanglingImages).
+		Bool("unusedImages", command.UnusedImages).
+		Bool("volumes", command.Volumes).
+		Bool("networks", command.Networks).
+		Bool("buildCache", command.BuildCache).
+		Bool("dryRun", command.DryRun).
+		Msg("Pruning")
+
+	result, pruneErr := pruneFunc(ctx, command)
+	if pruneErr != nil {
+		log.Error().Stack().Err(pruneErr).Msg("Failed to prune")
+
+		if result == nil {
+			result = &agent.SystemPruneMessage{
+				DryRun: command.DryRun,
+			}
+		}
+	} else {
+		log.Info().Uint64("spaceReclaimed", result.SpaceReclaimed).Bool("dryRun", result.DryRun).Msg("Prune finished")
+	}
+	result.Error, result.ErrorCode = responseError(pruneErr)
+
+	_, err := grpcConn.Client.SystemPrune(ctx, result)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("System prune response error")
+		return err
+	}
+
+	return pruneErr
 }
 
 func WithGRPCConfig(parentContext context.Context, cfg any) context.Context {
@@ -0,0 +1,153 @@
+package grpc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dyrector-io/darklens/agent/internal/config"
+)
+
+var ErrCertificateNotPinned = errors.New("the certificate of the backend does not match any pinned fingerprint")
+
+// Whether the certificate of the backend is verified, otherwise the presented one is trusted on first use
+func isTrustConfigured(appConfig *config.Configuration) bool {
+	return appConfig.GrpcSystemRoots || appConfig.GrpcCaFile != "" || len(parseCertFingerprints(appConfig.GrpcCertFingerprints)) > 0
+}
+
+func parseCertFingerprints(values []string) []string {
+	fingerprints := []string{}
+	for _, it := range values {
+		fingerprint := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(it), ":", ""))
+		if fingerprint != "" {
+			fingerprints = append(fingerprints, fingerprint)
+		}
+	}
+
+	return fingerprints
+}
+
+// SHA-256 fingerprints, colons are allowed between the bytes
+func decodeCertFingerprints(values []string) ([][]byte, error) {
+	pins := [][]byte{}
+	for _, it := range parseCertFingerprints(values) {
+		pin, err := hex.DecodeString(it)
+		if err != nil || len(pin) != sha256.Size {
+			return nil, fmt.Errorf("invalid SHA-256 certificate fingerprint: %s", it)
+		}
+
+		pins = append(pins, pin)
+	}
+
+	return pins, nil
+}
+
+func isCertPinned(raw []byte, pins [][]byte) bool {
+	sum := sha256.Sum256(raw)
+	for _, pin := range pins {
+		if bytes.Equal(sum[:], pin) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func loadRootCAs(appConfig *config.Configuration) (*x509.CertPool, error) {
+	var pool *x509.CertPool
+	if appConfig.GrpcSystemRoots {
+		systemPool, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load system roots: %w", err)
+		}
+		pool = systemPool
+	}
+
+	if appConfig.GrpcCaFile != "" {
+		bundle, err := os.ReadFile(appConfig.GrpcCaFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+
+		if pool == nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(bundle) {
+			return nil, fmt.Errorf("no certificates found in CA file: %s", appConfig.GrpcCaFile)
+		}
+	}
+
+	return pool, nil
+}
+
+// Builds the TLS configuration of the backend connection, the root CAs are not set when trusting on first use
+func newTLSConfig(appConfig *config.Configuration) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if appConfig.GrpcClientCert != "" || appConfig.GrpcClientKey != "" {
+		if appConfig.GrpcClientCert == "" || appConfig.GrpcClientKey == "" {
+			return nil, errors.New("both the client certificate and key are required for mutual TLS")
+		}
+
+		clientCert, err := tls.LoadX509KeyPair(appConfig.GrpcClientCert, appConfig.GrpcClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	pool, err := loadRootCAs(appConfig)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.RootCAs = pool
+
+	pins, err := decodeCertFingerprints(appConfig.GrpcCertFingerprints)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pins) < 1 {
+		return tlsConfig, nil
+	}
+
+	if pool == nil {
+		// only the leaf can be pinned, the rest of the chain is not verified
+		// #nosec G402 -- the certificate is verified by its fingerprint
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) < 1 || !isCertPinned(rawCerts[0], pins) {
+				return ErrCertificateNotPinned
+			}
+
+			return nil
+		}
+
+		return tlsConfig, nil
+	}
+
+	// any certificate of a verified chain can be pinned
+	tlsConfig.VerifyPeerCertificate = func(_ [][]byte, verifiedChains [][]*x509.Certificate) error {
+		for _, chain := range verifiedChains {
+			for _, cert := range chain {
+				if isCertPinned(cert.Raw, pins) {
+					return nil
+				}
+			}
+		}
+
+		return ErrCertificateNotPinned
+	}
+
+	return tlsConfig, nil
+}
@@ -7,7 +7,7 @@
 	"github.com/rs/zerolog/log"
 )
 
-const ReceiveBufferSize = 1024
+const ReceiveBufferSize = 4096
 
 func GetHealthy() (bool, error) {
 	status, err := GetStatus()
@@ -6,13 +6,21 @@
 	"errors"
 	"net"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/rs/zerolog/log"
 )
 
-var health = Status{
-	Connected: false,
-}
+// Errors are shortened, so the status fits into the receive buffer of the client
+const maxLastErrorLength = 1024
+
+var (
+	health = Status{
+		Connected: false,
+	}
+	healthMutex sync.Mutex
+)
 
 func sendHealthData(conn net.Conn, healthData *Status) error {
 	data, err := json.Marshal(healthData)
@@ -32,7 +40,11 @@ func acceptLoop(socket net.Listener) {
 			break
 		}
 
-		err = sendHealthData(conn, &health)
+		healthMutex.Lock()
+		current := health
+		healthMutex.Unlock()
+
+		err = sendHealthData(conn, &current)
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to write health socket")
 		}
@@ -45,7 +57,31 @@ func acceptLoop(socket net.Listener) {
 }
 
 func SetHealthGRPCStatus(connected bool) {
+	healthMutex.Lock()
+	defer healthMutex.Unlock()
+
 	health.Connected = connected
+	if connected {
+		health.RetryAttempt = 0
+		health.NextRetry = time.Time{}
+		health.LastError = ""
+	}
+}
+
+func SetHealthRetryStatus(attempt int, nextRetry time.Time, lastErr error) {
+	healthMutex.Lock()
+	defer healthMutex.Unlock()
+
+	health.Connected = false
+	health.RetryAttempt = attempt
+	health.NextRetry = nextRetry
+	health.LastError = ""
+	if lastErr != nil {
+		health.LastError = lastErr.Error()
+		if len(health.LastError) > maxLastErrorLength {
+			health.LastError = health.LastError[:maxLastErrorLength]
+		}
+	}
 }
 
 func Serve(ctx context.Context) error {
@@ -3,6 +3,7 @@
 import (
 	"os"
 	"path"
+	"time"
 )
 
 const (
@@ -12,6 +13,11 @@
 
 type Status struct {
 	Connected bool `json:"connected" binding:"required"`
+
+	// Set while reconnecting to the backend
+	RetryAttempt int       `json:"retryAttempt,omitempty"`
+	NextRetry    time.Time `json:"nextRetry,omitempty"`
+	LastError    string    `json:"lastError,omitempty"`
 }
 
 func getSocketDir() string {
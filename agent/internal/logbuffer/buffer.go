@@ -0,0 +1,429 @@
+// Package logbuffer stores the followed container logs on disk, so streaming can be resumed after a disconnect
+package logbuffer
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/dyrector-io/darklens/protobuf/go/agent"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	segmentExtension   = ".log"
+	recordHeaderLength = 4
+	dirPerm            = 0o700
+	filePerm           = 0o600
+)
+
+var (
+	ErrClosed       = errors.New("log buffer is closed")
+	ErrReaderClosed = errors.New("log buffer reader is closed")
+)
+
+// Buffer is a bounded ring of log messages. Messages are appended to the current segment, when it
+// reaches half of the size limit a new segment is started and the one before the current is removed.
+type Buffer struct {
+	dir         string
+	segmentSize int64
+
+	mutex      sync.Mutex
+	generation uint64
+	active     *os.File
+	activeSize int64
+	last       *agent.ContainerLogMessage
+	updated    chan struct{}
+	closed     bool
+}
+
+// Reader reads the messages of a buffer from an offset, waiting for new ones at the end
+type Reader struct {
+	buffer *Buffer
+
+	// guards the read state, Next releases it only while waiting for new messages
+	mutex      sync.Mutex
+	next       uint64
+	generation uint64
+	file       *os.File
+	position   int64
+
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+func segmentPath(dir string, generation uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%d%s", generation, segmentExtension))
+}
+
+func listSegments(dir string) ([]uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	generations := []uint64{}
+	for _, it := range entries {
+		name, found := strings.CutSuffix(it.Name(), segmentExtension)
+		if !found || it.IsDir() {
+			continue
+		}
+
+		generation, err := strconv.ParseUint(name, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		generations = append(generations, generation)
+	}
+
+	sort.Slice(generations, func(i, j int) bool {
+		return generations[i] < generations[j]
+	})
+
+	return generations, nil
+}
+
+// Returns io.EOF when the record at the position is not complete yet
+func readRecord(file io.ReaderAt, position int64) (*agent.ContainerLogMessage, int64, error) {
+	header := make([]byte, recordHeaderLength)
+
+	_, err := file.ReadAt(header, position)
+	if err != nil {
+		return nil, position, err
+	}
+
+	data := make([]byte, binary.BigEndian.Uint32(header))
+
+	_, err = file.ReadAt(data, position+recordHeaderLength)
+	if err != nil {
+		return nil, position, err
+	}
+
+	message := &agent.ContainerLogMessage{}
+
+	err = proto.Unmarshal(data, message)
+	if err != nil {
+		return nil, position, err
+	}
+
+	return message, position + recordHeaderLength + int64(len(data)), nil
+}
+
+// Returns the last message and the size of the complete records, an interrupted write is not part of it
+func scanSegment(path string) (*agent.ContainerLogMessage, int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer file.Close()
+
+	var last *agent.ContainerLogMessage
+	position := int64(0)
+
+	for {
+		message, next, err := readRecord(file, position)
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return last, position, nil
+			}
+
+			return nil, 0, err
+		}
+
+		last = message
+		position = next
+	}
+}
+
+func Open(dir string, maxSize int64) (*Buffer, error) {
+	err := os.MkdirAll(dir, dirPerm)
+	if err != nil {
+		return nil, err
+	}
+
+	generations, err := listSegments(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := &Buffer{
+		dir:         dir,
+		segmentSize: maxSize / 2,
+		generation:  1,
+		updated:     make(chan struct{}),
+	}
+
+	activeSize := int64(0)
+
+	for i, generation := range generations {
+		path := segmentPath(dir, generation)
+
+		// only the current and the previous segment are kept
+		if i < len(generations)-2 {
+			err = os.Remove(path)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		last, size, err := scanSegment(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if last != nil {
+			buffer.last = last
+		}
+
+		buffer.generation = generation
+		activeSize = size
+	}
+
+	active, err := os.OpenFile(segmentPath(dir, buffer.generation), os.O_CREATE|os.O_WRONLY, filePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	// drop the record interrupted by a crash
+	err = active.Truncate(activeSize)
+	if err == nil {
+		_, err = active.Seek(activeSize, io.SeekStart)
+	}
+	if err != nil {
+		active.Close()
+		return nil, err
+	}
+
+	buffer.active = active
+	buffer.activeSize = activeSize
+
+	return buffer, nil
+}
+
+func (buffer *Buffer) rotate() error {
+	err := buffer.active.Close()
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(segmentPath(buffer.dir, buffer.generation-1))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	active, err := os.OpenFile(segmentPath(buffer.dir, buffer.generation+1), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, filePerm)
+	if err != nil {
+		return err
+	}
+
+	buffer.generation++
+	buffer.active = active
+	buffer.activeSize = 0
+
+	return nil
+}
+
+// Append stores the message with the next offset
+func (buffer *Buffer) Append(message *agent.ContainerLogMessage) (uint64, error) {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+
+	if buffer.closed {
+		return 0, ErrClosed
+	}
+
+	if buffer.activeSize >= buffer.segmentSize {
+		err := buffer.rotate()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	message.Offset = buffer.last.GetOffset() + 1
+
+	data, err := proto.Marshal(message)
+	if err != nil {
+		return 0, err
+	}
+
+	record := make([]byte, recordHeaderLength+len(data))
+	binary.BigEndian.PutUint32(record, uint32(len(data)))
+	copy(record[recordHeaderLength:], data)
+
+	_, err = buffer.active.Write(record)
+	if err != nil {
+		return 0, err
+	}
+
+	buffer.activeSize += int64(len(record))
+	buffer.last = message
+
+	close(buffer.updated)
+	buffer.updated = make(chan struct{})
+
+	return message.Offset, nil
+}
+
+// Last returns the last stored message, nil when the buffer is empty
+func (buffer *Buffer) Last() *agent.ContainerLogMessage {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+
+	return buffer.last
+}
+
+// Close stops appending, readers get io.EOF after the last message
+func (buffer *Buffer) Close() error {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+
+	if buffer.closed {
+		return nil
+	}
+
+	buffer.closed = true
+	close(buffer.updated)
+
+	return buffer.active.Close()
+}
+
+// Remove closes the buffer and deletes its files
+func (buffer *Buffer) Remove() error {
+	err := buffer.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(buffer.dir)
+}
+
+// NewReader returns a reader starting at the offset, or at the oldest message still stored
+func (buffer *Buffer) NewReader(offset uint64) *Reader {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+
+	return &Reader{
+		buffer:     buffer,
+		next:       offset,
+		generation: buffer.generation - 1,
+		closing:    make(chan struct{}),
+	}
+}
+
+func (reader *Reader) closeFile() {
+	if reader.file != nil {
+		reader.file.Close()
+		reader.file = nil
+	}
+}
+
+func (reader *Reader) nextSegment() {
+	reader.closeFile()
+	reader.generation++
+}
+
+// Next blocks until the next message is available, it returns ErrReaderClosed after Close
+func (reader *Reader) Next(ctx context.Context) (*agent.ContainerLogMessage, error) {
+	reader.mutex.Lock()
+	defer reader.mutex.Unlock()
+
+	for {
+		select {
+		case <-reader.closing:
+			return nil, ErrReaderClosed
+		default:
+		}
+
+		reader.buffer.mutex.Lock()
+		current := reader.buffer.generation
+		updated := reader.buffer.updated
+		closed := reader.buffer.closed
+		reader.buffer.mutex.Unlock()
+
+		// the segment was removed while reading, continue with the oldest one
+		if reader.generation+1 < current {
+			reader.closeFile()
+			reader.generation = current - 1
+		}
+
+		if reader.file == nil {
+			file, err := os.Open(segmentPath(reader.buffer.dir, reader.generation))
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) && reader.generation < current {
+					reader.nextSegment()
+					continue
+				}
+
+				return nil, err
+			}
+
+			reader.file = file
+			reader.position = 0
+		}
+
+		message, next, err := readRecord(reader.file, reader.position)
+		if err == nil {
+			reader.position = next
+
+			if message.Offset < reader.next {
+				continue
+			}
+
+			reader.next = message.Offset + 1
+			return message, nil
+		}
+
+		if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, err
+		}
+
+		if reader.generation < current {
+			reader.nextSegment()
+			continue
+		}
+
+		if closed {
+			return nil, io.EOF
+		}
+
+		reader.mutex.Unlock()
+
+		var waitErr error
+		select {
+		case <-ctx.Done():
+			waitErr = ctx.Err()
+		case <-reader.closing:
+		case <-updated:
+		}
+
+		reader.mutex.Lock()
+
+		if waitErr != nil {
+			return nil, waitErr
+		}
+	}
+}
+
+// Close can be called while Next is waiting, it wakes up Next
+func (reader *Reader) Close() error {
+	reader.closeOnce.Do(func() {
+		close(reader.closing)
+	})
+
+	reader.mutex.Lock()
+	defer reader.mutex.Unlock()
+
+	reader.closeFile()
+
+	return nil
+}
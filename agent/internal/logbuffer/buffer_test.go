@@ -0,0 +1,211 @@
+package logbuffer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/dyrector-io/darklens/protobuf/go/agent"
+)
+
+func appendLines(t *testing.T, buffer *Buffer, from, count int) {
+	t.Helper()
+
+	for i := from; i < from+count; i++ {
+		_, err := buffer.Append(&agent.ContainerLogMessage{Log: fmt.Sprintf("line %d\n", i)})
+		if err != nil {
+			t.Fatalf("append %d: %v", i, err)
+		}
+	}
+}
+
+func readOffsets(t *testing.T, reader *Reader, count int) []uint64 {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	offsets := []uint64{}
+	for i := 0; i < count; i++ {
+		message, err := reader.Next(ctx)
+		if err != nil {
+			t.Fatalf("next after %v: %v", offsets, err)
+		}
+		offsets = append(offsets, message.Offset)
+	}
+
+	return offsets
+}
+
+func expectOffsets(t *testing.T, offsets []uint64, from uint64) {
+	t.Helper()
+
+	for i, it := range offsets {
+		if it != from+uint64(i) {
+			t.Fatalf("expected offsets from %d, got %v", from, offsets)
+		}
+	}
+}
+
+func TestAppendAndRead(t *testing.T) {
+	buffer, err := Open(t.TempDir(), 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer buffer.Close()
+
+	appendLines(t, buffer, 1, 3)
+
+	if buffer.Last().GetOffset() != 3 {
+		t.Fatalf("expected last offset 3, got %d", buffer.Last().GetOffset())
+	}
+
+	reader := buffer.NewReader(1)
+	defer reader.Close()
+
+	expectOffsets(t, readOffsets(t, reader, 3), 1)
+
+	// the reader waits for the next append
+	go func() {
+		_, err := buffer.Append(&agent.ContainerLogMessage{Log: "line 4\n"})
+		if err != nil {
+			t.Error(err)
+		}
+	}()
+	expectOffsets(t, readOffsets(t, reader, 1), 4)
+}
+
+func TestRotateKeepsTwoSegments(t *testing.T) {
+	dir := t.TempDir()
+
+	buffer, err := Open(dir, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer buffer.Close()
+
+	appendLines(t, buffer, 1, 100)
+
+	generations, err := listSegments(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(generations) != 2 {
+		t.Fatalf("expected 2 segments, got %v", generations)
+	}
+
+	// the oldest lines are gone, the reader starts at the oldest stored one
+	reader := buffer.NewReader(1)
+	defer reader.Close()
+
+	first := readOffsets(t, reader, 1)[0]
+	if first <= 1 {
+		t.Fatalf("expected the first lines to be rotated out, got offset %d", first)
+	}
+
+	expectOffsets(t, readOffsets(t, reader, int(100-first)), first+1)
+}
+
+func TestReopenAfterTruncatedRecord(t *testing.T) {
+	dir := t.TempDir()
+
+	buffer, err := Open(dir, 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appendLines(t, buffer, 1, 5)
+
+	err = buffer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a crash in the middle of writing the next record
+	file, err := os.OpenFile(segmentPath(dir, 1), os.O_WRONLY|os.O_APPEND, filePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = file.Write([]byte{0, 0, 0, 42, 1, 2})
+	file.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buffer, err = Open(dir, 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer buffer.Close()
+
+	if buffer.Last().GetOffset() != 5 {
+		t.Fatalf("expected last offset 5 after reopen, got %d", buffer.Last().GetOffset())
+	}
+
+	appendLines(t, buffer, 6, 2)
+
+	reader := buffer.NewReader(1)
+	defer reader.Close()
+
+	expectOffsets(t, readOffsets(t, reader, 7), 1)
+}
+
+func TestResumeFromOffset(t *testing.T) {
+	buffer, err := Open(t.TempDir(), 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appendLines(t, buffer, 1, 10)
+
+	reader := buffer.NewReader(7)
+	defer reader.Close()
+
+	expectOffsets(t, readOffsets(t, reader, 4), 7)
+
+	err = buffer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = reader.Next(context.Background())
+	if !errors.Is(err, io.EOF) {
+		t.Fatalf("expected io.EOF after the buffer is closed, got %v", err)
+	}
+}
+
+func TestCloseWakesNext(t *testing.T) {
+	buffer, err := Open(t.TempDir(), 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer buffer.Close()
+
+	reader := buffer.NewReader(1)
+
+	result := make(chan error)
+	go func() {
+		_, err := reader.Next(context.Background())
+		result <- err
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+
+	err = reader.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err = <-result:
+		if !errors.Is(err, ErrReaderClosed) {
+			t.Fatalf("expected ErrReaderClosed, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Next did not return after Close")
+	}
+}
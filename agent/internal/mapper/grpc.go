@@ -1,22 +1,34 @@
 package mapper
 
 import (
+	"context"
+	"errors"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	dockerTypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+	"github.com/dyrector-io/darklens/agent/internal/docker"
 	"github.com/dyrector-io/darklens/protobuf/go/agent"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// The container list only reports the exit code as part of the status, for example "Exited (137) 2 hours ago"
+var exitedStatusPattern = regexp.MustCompile(`^Exited \((-?\d+)\)`)
+
 func MapContainerState(it *dockerTypes.Container) *agent.ContainerStateItem {
 	if it == nil {
 		return nil
 	}
-	name := ""
-	if len(it.Names) > 0 {
-		name = strings.TrimPrefix(it.Names[0], "/")
-	}
+	name := MapContainerName(it)
 
 	imageName := strings.Split(it.Image, ":")
 
@@ -37,9 +49,137 @@ func MapContainerState(it *dockerTypes.Container) *agent.ContainerStateItem {
 		Ports:     mapContainerPorts(&it.Ports),
 		ImageName: imageName[0],
 		ImageTag:  imageTag,
+		Labels:    it.Labels,
+
+		ComposeProject: it.Labels[docker.ComposeProjectLabel],
+		ComposeService: it.Labels[docker.ComposeServiceLabel],
+
+		Id:       it.ID,
+		Health:   MapContainerHealthStatus(it.Status),
+		ExitCode: mapContainerExitCode(it.Status),
+		Networks: mapContainerNetworks(it.NetworkSettings),
+		Mounts:   mapContainerMounts(it.Mounts),
+	}
+}
+
+// The restart count and the exact health are only available when inspecting the container
+func MapContainerDetails(item *agent.ContainerStateItem, inspect *dockerTypes.ContainerJSON) {
+	if inspect == nil || inspect.ContainerJSONBase == nil {
+		return
+	}
+
+	item.RestartCount = int32(inspect.RestartCount)
+
+	state := inspect.State
+	if state == nil {
+		return
+	}
+
+	if state.Health != nil {
+		item.Health = MapDockerHealthToContainerHealth(state.Health.Status)
+	}
+
+	if state.Running {
+		item.ExitCode = nil
+	} else {
+		exitCode := int32(state.ExitCode)
+		item.ExitCode = &exitCode
+	}
+}
+
+func MapDockerHealthToContainerHealth(health string) agent.ContainerHealth {
+	switch health {
+	case dockerTypes.Starting:
+		return agent.ContainerHealth_STARTING
+	case dockerTypes.Healthy:
+		return agent.ContainerHealth_HEALTHY
+	case dockerTypes.Unhealthy:
+		return agent.ContainerHealth_UNHEALTHY
+	default:
+		return agent.ContainerHealth_CONTAINER_HEALTH_UNSPECIFIED
+	}
+}
+
+// Maps the health from a container list status, for example "Up 2 hours (healthy)"
+func MapContainerHealthStatus(status string) agent.ContainerHealth {
+	switch {
+	case strings.HasSuffix(status, "(health: starting)"):
+		return agent.ContainerHealth_STARTING
+	case strings.HasSuffix(status, "(healthy)"):
+		return agent.ContainerHealth_HEALTHY
+	case strings.HasSuffix(status, "(unhealthy)"):
+		return agent.ContainerHealth_UNHEALTHY
+	default:
+		return agent.ContainerHealth_CONTAINER_HEALTH_UNSPECIFIED
 	}
 }
 
+func mapContainerExitCode(status string) *int32 {
+	match := exitedStatusPattern.FindStringSubmatch(status)
+	if match == nil {
+		return nil
+	}
+
+	exitCode, err := strconv.ParseInt(match[1], 10, 32)
+	if err != nil {
+		return nil
+	}
+
+	result := int32(exitCode)
+	return &result
+}
+
+func mapContainerNetworks(in *dockerTypes.SummaryNetworkSettings) []*agent.ContainerStateItemNetwork {
+	networks := []*agent.ContainerStateItemNetwork{}
+	if in == nil {
+		return networks
+	}
+
+	for name, it := range in.Networks {
+		if it == nil {
+			continue
+		}
+
+		networks = append(networks, &agent.ContainerStateItemNetwork{
+			Name:        name,
+			IpAddress:   it.IPAddress,
+			Ipv6Address: it.GlobalIPv6Address,
+		})
+	}
+
+	sort.Slice(networks, func(i, j int) bool {
+		return networks[i].Name < networks[j].Name
+	})
+
+	return networks
+}
+
+func mapContainerMounts(in []dockerTypes.MountPoint) []*agent.ContainerStateItemMount {
+	mounts := []*agent.ContainerStateItemMount{}
+
+	for i := range in {
+		it := &in[i]
+
+		mounts = append(mounts, &agent.ContainerStateItemMount{
+			Type:        string(it.Type),
+			Name:        it.Name,
+			Source:      it.Source,
+			Destination: it.Destination,
+			ReadOnly:    !it.RW,
+		})
+	}
+
+	return mounts
+}
+
+func MapContainerName(it *dockerTypes.Container) string {
+	if len(it.Names) > 0 {
+		return strings.TrimPrefix(it.Names[0], "/")
+	}
+
+	return ""
+}
+
 func MapContainerStateList(in []dockerTypes.Container) []*agent.ContainerStateItem {
 	list := []*agent.ContainerStateItem{}
 
@@ -60,6 +200,8 @@ func mapContainerPorts(in *[]dockerTypes.Port) []*agent.ContainerStateItemPort {
 		ports = append(ports, &agent.ContainerStateItemPort{
 			Internal: int32(it.PrivatePort),
 			External: int32(it.PublicPort),
+			Protocol: it.Type,
+			HostIp:   it.IP,
 		})
 	}
 
@@ -95,6 +237,8 @@ func MapDockerContainerEventToContainerState(event string) agent.ContainerState
 		return agent.ContainerState_REMOVED
 	case "pause":
 		return agent.ContainerState_WAITING
+	case "unpause":
+		return agent.ContainerState_RUNNING
 	case "restart":
 		return agent.ContainerState_RUNNING
 	case "start":
@@ -107,3 +251,314 @@ func MapDockerContainerEventToContainerState(event string) agent.ContainerState
 		return agent.ContainerState_CONTAINER_STATE_UNSPECIFIED
 	}
 }
+
+// Docker reports health changes as "health_status: <status>"
+const dockerHealthStatusEvent = "health_status"
+
+func MapDockerContainerEventToStateEvent(event string) agent.ContainerStateEvent {
+	switch {
+	case strings.HasPrefix(event, dockerHealthStatusEvent):
+		return agent.ContainerStateEvent_HEALTH_CHANGED
+	case event == "oom":
+		return agent.ContainerStateEvent_OUT_OF_MEMORY
+	case event == "kill":
+		return agent.ContainerStateEvent_KILLED
+	default:
+		return agent.ContainerStateEvent_CONTAINER_STATE_EVENT_UNSPECIFIED
+	}
+}
+
+func MapDockerContainerEventReason(event string, attributes map[string]string) string {
+	switch MapDockerContainerEventToStateEvent(event) {
+	case agent.ContainerStateEvent_HEALTH_CHANGED:
+		return strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(event, dockerHealthStatusEvent), ":"))
+	case agent.ContainerStateEvent_OUT_OF_MEMORY:
+		return "out of memory"
+	case agent.ContainerStateEvent_KILLED:
+		signal, ok := attributes["signal"]
+		if !ok {
+			return "killed"
+		}
+		return "killed with signal " + signal
+	default:
+		return ""
+	}
+}
+
+func MapDockerEvent(it *events.Message) *agent.ContainerEventMessage {
+	return &agent.ContainerEventMessage{
+		Timestamp:  timestamppb.New(time.Unix(0, it.TimeNano).UTC()),
+		Type:       string(it.Type),
+		Action:     it.Action,
+		ActorId:    it.Actor.ID,
+		Attributes: it.Actor.Attributes,
+		Scope:      it.Scope,
+	}
+}
+
+func MapErrorCode(err error) agent.ErrorCode {
+	switch {
+	case err == nil:
+		return agent.ErrorCode_ERROR_CODE_UNSPECIFIED
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded), errdefs.IsCancelled(err), errdefs.IsDeadline(err):
+		return agent.ErrorCode_CANCELED
+	case errors.Is(err, os.ErrPermission), errdefs.IsUnauthorized(err), errdefs.IsForbidden(err):
+		return agent.ErrorCode_PERMISSION_DENIED
+	case client.IsErrConnectionFailed(err), errdefs.IsUnavailable(err), errors.Is(err, docker.ErrCannotConnectToServer):
+		return agent.ErrorCode_RUNTIME_UNREACHABLE
+	case errors.Is(err, docker.ErrAmbiguousContainer):
+		return agent.ErrorCode_AMBIGUOUS
+	case errors.Is(err, docker.ErrContainerNotFound), errdefs.IsNotFound(err):
+		return agent.ErrorCode_NOT_FOUND
+	case errdefs.IsConflict(err):
+		return agent.ErrorCode_CONFLICT
+	case errdefs.IsInvalidParameter(err):
+		return agent.ErrorCode_INVALID_ARGUMENT
+	case errdefs.IsNotImplemented(err):
+		return agent.ErrorCode_NOT_IMPLEMENTED
+	default:
+		return agent.ErrorCode_INTERNAL_ERROR
+	}
+}
+
+// The runtime reports pull failures as text only, the known messages of missing images and denied access are recognized
+func MapImagePullErrorCode(message string) agent.ErrorCode {
+	message = strings.ToLower(message)
+
+	switch {
+	case message == "":
+		return agent.ErrorCode_ERROR_CODE_UNSPECIFIED
+	case strings.Contains(message, "manifest unknown"), strings.Contains(message, "not found"), strings.Contains(message, "does not exist"):
+		return agent.ErrorCode_NOT_FOUND
+	case strings.Contains(message, "unauthorized"), strings.Contains(message, "denied"):
+		return agent.ErrorCode_PERMISSION_DENIED
+	default:
+		return agent.ErrorCode_INTERNAL_ERROR
+	}
+}
+
+func MapImageList(images []dockerTypes.ImageSummary, containers []dockerTypes.Container) []*agent.ImageItem {
+	containersByImage := map[string][]string{}
+	for i := range containers {
+		it := &containers[i]
+		containersByImage[it.ImageID] = append(containersByImage[it.ImageID], MapContainerName(it))
+	}
+
+	list := []*agent.ImageItem{}
+
+	for i := range images {
+		it := &images[i]
+
+		tags := []string{}
+		for _, tag := range it.RepoTags {
+			if tag != "<none>:<none>" {
+				tags = append(tags, tag)
+			}
+		}
+
+		digests := []string{}
+		for _, digest := range it.RepoDigests {
+			if digest != "<none>@<none>" {
+				digests = append(digests, digest)
+			}
+		}
+
+		list = append(list, &agent.ImageItem{
+			Id:         it.ID,
+			Tags:       tags,
+			Digests:    digests,
+			Size:       it.Size,
+			CreatedAt:  timestamppb.New(time.Unix(it.Created, 0).UTC()),
+			Dangling:   len(tags) == 0,
+			Containers: containersByImage[it.ID],
+		})
+	}
+
+	return list
+}
+
+func MapVolumeList(volumes []*volume.Volume, containers []dockerTypes.Container) []*agent.VolumeItem {
+	containersByVolume := map[string][]string{}
+	for i := range containers {
+		it := &containers[i]
+		for _, mountPoint := range it.Mounts {
+			if mountPoint.Type == mount.TypeVolume {
+				containersByVolume[mountPoint.Name] = append(containersByVolume[mountPoint.Name], MapContainerName(it))
+			}
+		}
+	}
+
+	list := []*agent.VolumeItem{}
+
+	for _, it := range volumes {
+		var createdAt *timestamppb.Timestamp
+		if created, err := time.Parse(time.RFC3339, it.CreatedAt); err == nil {
+			createdAt = timestamppb.New(created.UTC())
+		}
+
+		list = append(list, &agent.VolumeItem{
+			Name:       it.Name,
+			Driver:     it.Driver,
+			Mountpoint: it.Mountpoint,
+			Scope:      it.Scope,
+			CreatedAt:  createdAt,
+			Labels:     it.Labels,
+			Containers: containersByVolume[it.Name],
+		})
+	}
+
+	return list
+}
+
+func MapNetworkList(networks []dockerTypes.NetworkResource, containers []dockerTypes.Container) []*agent.NetworkItem {
+	containersByNetwork := map[string][]string{}
+	for i := range containers {
+		it := &containers[i]
+		if it.NetworkSettings == nil {
+			continue
+		}
+
+		for _, endpoint := range it.NetworkSettings.Networks {
+			if endpoint != nil {
+				containersByNetwork[endpoint.NetworkID] = append(containersByNetwork[endpoint.NetworkID], MapContainerName(it))
+			}
+		}
+	}
+
+	list := []*agent.NetworkItem{}
+
+	for i := range networks {
+		it := &networks[i]
+
+		subnets := []string{}
+		for _, config := range it.IPAM.Config {
+			if config.Subnet != "" {
+				subnets = append(subnets, config.Subnet)
+			}
+		}
+
+		list = append(list, &agent.NetworkItem{
+			Id:         it.ID,
+			Name:       it.Name,
+			Driver:     it.Driver,
+			Scope:      it.Scope,
+			Internal:   it.Internal,
+			CreatedAt:  timestamppb.New(it.Created.UTC()),
+			Labels:     it.Labels,
+			Subnets:    subnets,
+			Containers: containersByNetwork[it.ID],
+		})
+	}
+
+	return list
+}
+
+// MapContainerStats calculates a sample relative to the previously sent one, like 'docker stats' does
+func MapContainerStats(current, previous *dockerTypes.StatsJSON) *agent.ContainerStatsMessage {
+	if current == nil {
+		return nil
+	}
+
+	// the first sample has no I/O since a previous one, only the CPU is sampled ahead by the runtime
+	previousCPU := current.PreCPUStats
+	previousNetworks := current.Networks
+	previousBlkio := current.BlkioStats
+	if previous != nil {
+		previousCPU = previous.CPUStats
+		previousNetworks = previous.Networks
+		previousBlkio = previous.BlkioStats
+	}
+
+	memoryUsage := calculateMemoryUsage(&current.MemoryStats)
+	memoryPercent := 0.0
+	if current.MemoryStats.Limit > 0 {
+		memoryPercent = float64(memoryUsage) / float64(current.MemoryStats.Limit) * 100.0
+	}
+
+	networkRx, networkTx := calculateNetworkDelta(current.Networks, previousNetworks)
+	blockRead, blockWrite := calculateBlkioDelta(&current.BlkioStats, &previousBlkio)
+
+	return &agent.ContainerStatsMessage{
+		Timestamp:     timestamppb.New(current.Read.UTC()),
+		CpuPercent:    calculateCPUPercent(&current.CPUStats, &previousCPU),
+		MemoryUsage:   memoryUsage,
+		MemoryLimit:   current.MemoryStats.Limit,
+		MemoryPercent: memoryPercent,
+		NetworkRx:     networkRx,
+		NetworkTx:     networkTx,
+		BlockRead:     blockRead,
+		BlockWrite:    blockWrite,
+		Pids:          current.PidsStats.Current,
+	}
+}
+
+func calculateCPUPercent(current, previous *dockerTypes.CPUStats) float64 {
+	cpuDelta := float64(current.CPUUsage.TotalUsage) - float64(previous.CPUUsage.TotalUsage)
+	systemDelta := float64(current.SystemUsage) - float64(previous.SystemUsage)
+
+	onlineCPUs := float64(current.OnlineCPUs)
+	if onlineCPUs == 0.0 {
+		onlineCPUs = float64(len(current.CPUUsage.PercpuUsage))
+	}
+
+	if cpuDelta <= 0.0 || systemDelta <= 0.0 {
+		return 0.0
+	}
+
+	return cpuDelta / systemDelta * onlineCPUs * 100.0
+}
+
+// Page cache is not counted as used memory, cgroup v1 and v2 report it with different keys
+func calculateMemoryUsage(stats *dockerTypes.MemoryStats) uint64 {
+	cache, ok := stats.Stats["total_inactive_file"]
+	if !ok {
+		cache = stats.Stats["inactive_file"]
+	}
+
+	if cache > stats.Usage {
+		return stats.Usage
+	}
+
+	return stats.Usage - cache
+}
+
+func calculateNetworkDelta(current, previous map[string]dockerTypes.NetworkStats) (rx, tx uint64) {
+	for name := range current {
+		it := current[name]
+		prev := previous[name]
+
+		rx += counterDelta(it.RxBytes, prev.RxBytes)
+		tx += counterDelta(it.TxBytes, prev.TxBytes)
+	}
+
+	return rx, tx
+}
+
+func calculateBlkioDelta(current, previous *dockerTypes.BlkioStats) (read, write uint64) {
+	sumBlkio := func(entries []dockerTypes.BlkioStatEntry) (read, write uint64) {
+		for _, it := range entries {
+			switch strings.ToLower(it.Op) {
+			case "read":
+				read += it.Value
+			case "write":
+				write += it.Value
+			}
+		}
+
+		return read, write
+	}
+
+	currentRead, currentWrite := sumBlkio(current.IoServiceBytesRecursive)
+	previousRead, previousWrite := sumBlkio(previous.IoServiceBytesRecursive)
+
+	return counterDelta(currentRead, previousRead), counterDelta(currentWrite, previousWrite)
+}
+
+// Counters are reset when the container restarts
+func counterDelta(current, previous uint64) uint64 {
+	if current < previous {
+		return current
+	}
+
+	return current - previous
+}
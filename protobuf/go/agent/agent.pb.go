@@ -80,6 +80,9 @@ func (CloseReason) EnumDescriptor() ([]byte, []int) {
 	ContainerOperation_START_CONTAINER                 ContainerOperation = 1
 	ContainerOperation_STOP_CONTAINER                  ContainerOperation = 2
 	ContainerOperation_RESTART_CONTAINER               ContainerOperation = 3
+	ContainerOperation_PAUSE_CONTAINER                 ContainerOperation = 4
+	ContainerOperation_UNPAUSE_CONTAINER               ContainerOperation = 5
+	ContainerOperation_KILL_CONTAINER                  ContainerOperation = 6
 )
 
 // Enum value maps for ContainerOperation.
@@ -89,12 +92,18 @@ func (CloseReason) EnumDescriptor() ([]byte, []int) {
 		1: "START_CONTAINER",
 		2: "STOP_CONTAINER",
 		3: "RESTART_CONTAINER",
+		4: "PAUSE_CONTAINER",
+		5: "UNPAUSE_CONTAINER",
+		6: "KILL_CONTAINER",
 	}
 	ContainerOperation_value = map[string]int32{
 		"CONTAINER_OPERATION_UNSPECIFIED": 0,
 		"START_CONTAINER":                 1,
 		"STOP_CONTAINER":                  2,
 		"RESTART_CONTAINER":               3,
+		"PAUSE_CONTAINER":                 4,
+		"UNPAUSE_CONTAINER":               5,
+		"KILL_CONTAINER":                  6,
 	}
 )
 
@@ -125,6 +134,133 @@ func (ContainerOperation) EnumDescriptor() ([]byte, []int) {
 	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{1}
 }
 
+type StackOperation int32
+
+const (
+	StackOperation_STACK_OPERATION_UNSPECIFIED StackOperation = 0
+	StackOperation_START_STACK                 StackOperation = 1
+	StackOperation_STOP_STACK                  StackOperation = 2
+	StackOperation_RESTART_STACK               StackOperation = 3
+	StackOperation_DELETE_STACK                StackOperation = 4
+)
+
+// Enum value maps for StackOperation.
+var (
+	StackOperation_name = map[int32]string{
+		0: "STACK_OPERATION_UNSPECIFIED",
+		1: "START_STACK",
+		2: "STOP_STACK",
+		3: "RESTART_STACK",
+		4: "DELETE_STACK",
+	}
+	StackOperation_value = map[string]int32{
+		"STACK_OPERATION_UNSPECIFIED": 0,
+		"START_STACK":                 1,
+		"STOP_STACK":                  2,
+		"RESTART_STACK":               3,
+		"DELETE_STACK":                4,
+	}
+)
+
+func (x StackOperation) Enum() *StackOperation {
+	p := new(StackOperation)
+	*p = x
+	return p
+}
+
+func (x StackOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StackOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_proto_agent_proto_enumTypes[2].Descriptor()
+}
+
+func (StackOperation) Type() protoreflect.EnumType {
+	return &file_protobuf_proto_agent_proto_enumTypes[2]
+}
+
+func (x StackOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StackOperation.Descriptor instead.
+func (StackOperation) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{2}
+}
+
+// Command result
+type ErrorCode int32
+
+const (
+	ErrorCode_ERROR_CODE_UNSPECIFIED ErrorCode = 0
+	ErrorCode_INTERNAL_ERROR         ErrorCode = 1
+	ErrorCode_NOT_FOUND              ErrorCode = 2
+	ErrorCode_CONFLICT               ErrorCode = 3
+	ErrorCode_INVALID_ARGUMENT       ErrorCode = 4
+	ErrorCode_PERMISSION_DENIED      ErrorCode = 5
+	ErrorCode_RUNTIME_UNREACHABLE    ErrorCode = 6
+	ErrorCode_NOT_IMPLEMENTED        ErrorCode = 7
+	ErrorCode_CANCELED               ErrorCode = 8
+	// More than one container matched the name
+	ErrorCode_AMBIGUOUS ErrorCode = 9
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0: "ERROR_CODE_UNSPECIFIED",
+		1: "INTERNAL_ERROR",
+		2: "NOT_FOUND",
+		3: "CONFLICT",
+		4: "INVALID_ARGUMENT",
+		5: "PERMISSION_DENIED",
+		6: "RUNTIME_UNREACHABLE",
+		7: "NOT_IMPLEMENTED",
+		8: "CANCELED",
+		9: "AMBIGUOUS",
+	}
+	ErrorCode_value = map[string]int32{
+		"ERROR_CODE_UNSPECIFIED": 0,
+		"INTERNAL_ERROR":         1,
+		"NOT_FOUND":              2,
+		"CONFLICT":               3,
+		"INVALID_ARGUMENT":       4,
+		"PERMISSION_DENIED":      5,
+		"RUNTIME_UNREACHABLE":    6,
+		"NOT_IMPLEMENTED":        7,
+		"CANCELED":               8,
+		"AMBIGUOUS":              9,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_proto_agent_proto_enumTypes[3].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_protobuf_proto_agent_proto_enumTypes[3]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{3}
+}
+
 type ContainerState int32
 
 const (
@@ -164,11 +300,11 @@ func (x ContainerState) String() string {
 }
 
 func (ContainerState) Descriptor() protoreflect.EnumDescriptor {
-	return file_protobuf_proto_agent_proto_enumTypes[2].Descriptor()
+	return file_protobuf_proto_agent_proto_enumTypes[4].Descriptor()
 }
 
 func (ContainerState) Type() protoreflect.EnumType {
-	return &file_protobuf_proto_agent_proto_enumTypes[2]
+	return &file_protobuf_proto_agent_proto_enumTypes[4]
 }
 
 func (x ContainerState) Number() protoreflect.EnumNumber {
@@ -177,10 +313,279 @@ func (x ContainerState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ContainerState.Descriptor instead.
 func (ContainerState) EnumDescriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{2}
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{4}
+}
+
+// The event that caused a state update without changing the state
+type ContainerStateEvent int32
+
+const (
+	ContainerStateEvent_CONTAINER_STATE_EVENT_UNSPECIFIED ContainerStateEvent = 0
+	ContainerStateEvent_HEALTH_CHANGED                    ContainerStateEvent = 1
+	ContainerStateEvent_OUT_OF_MEMORY                     ContainerStateEvent = 2
+	ContainerStateEvent_KILLED                            ContainerStateEvent = 3
+	// The container was started too many times within the configured window
+	ContainerStateEvent_CRASH_LOOP ContainerStateEvent = 4
+)
+
+// Enum value maps for ContainerStateEvent.
+var (
+	ContainerStateEvent_name = map[int32]string{
+		0: "CONTAINER_STATE_EVENT_UNSPECIFIED",
+		1: "HEALTH_CHANGED",
+		2: "OUT_OF_MEMORY",
+		3: "KILLED",
+		4: "CRASH_LOOP",
+	}
+	ContainerStateEvent_value = map[string]int32{
+		"CONTAINER_STATE_EVENT_UNSPECIFIED": 0,
+		"HEALTH_CHANGED":                    1,
+		"OUT_OF_MEMORY":                     2,
+		"KILLED":                            3,
+		"CRASH_LOOP":                        4,
+	}
+)
+
+func (x ContainerStateEvent) Enum() *ContainerStateEvent {
+	p := new(ContainerStateEvent)
+	*p = x
+	return p
+}
+
+func (x ContainerStateEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContainerStateEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_proto_agent_proto_enumTypes[5].Descriptor()
+}
+
+func (ContainerStateEvent) Type() protoreflect.EnumType {
+	return &file_protobuf_proto_agent_proto_enumTypes[5]
+}
+
+func (x ContainerStateEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContainerStateEvent.Descriptor instead.
+func (ContainerStateEvent) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{5}
+}
+
+type ContainerHealth int32
+
+const (
+	// The container has no healthcheck
+	ContainerHealth_CONTAINER_HEALTH_UNSPECIFIED ContainerHealth = 0
+	ContainerHealth_STARTING                     ContainerHealth = 1
+	ContainerHealth_HEALTHY                      ContainerHealth = 2
+	ContainerHealth_UNHEALTHY                    ContainerHealth = 3
+)
+
+// Enum value maps for ContainerHealth.
+var (
+	ContainerHealth_name = map[int32]string{
+		0: "CONTAINER_HEALTH_UNSPECIFIED",
+		1: "STARTING",
+		2: "HEALTHY",
+		3: "UNHEALTHY",
+	}
+	ContainerHealth_value = map[string]int32{
+		"CONTAINER_HEALTH_UNSPECIFIED": 0,
+		"STARTING":                     1,
+		"HEALTHY":                      2,
+		"UNHEALTHY":                    3,
+	}
+)
+
+func (x ContainerHealth) Enum() *ContainerHealth {
+	p := new(ContainerHealth)
+	*p = x
+	return p
+}
+
+func (x ContainerHealth) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContainerHealth) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_proto_agent_proto_enumTypes[6].Descriptor()
+}
+
+func (ContainerHealth) Type() protoreflect.EnumType {
+	return &file_protobuf_proto_agent_proto_enumTypes[6]
+}
+
+func (x ContainerHealth) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContainerHealth.Descriptor instead.
+func (ContainerHealth) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{6}
+}
+
+type LogFormat int32
+
+const (
+	LogFormat_LOG_FORMAT_UNSPECIFIED LogFormat = 0
+	LogFormat_JSON                   LogFormat = 1
+	LogFormat_LOGFMT                 LogFormat = 2
+)
+
+// Enum value maps for LogFormat.
+var (
+	LogFormat_name = map[int32]string{
+		0: "LOG_FORMAT_UNSPECIFIED",
+		1: "JSON",
+		2: "LOGFMT",
+	}
+	LogFormat_value = map[string]int32{
+		"LOG_FORMAT_UNSPECIFIED": 0,
+		"JSON":                   1,
+		"LOGFMT":                 2,
+	}
+)
+
+func (x LogFormat) Enum() *LogFormat {
+	p := new(LogFormat)
+	*p = x
+	return p
+}
+
+func (x LogFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_proto_agent_proto_enumTypes[7].Descriptor()
+}
+
+func (LogFormat) Type() protoreflect.EnumType {
+	return &file_protobuf_proto_agent_proto_enumTypes[7]
+}
+
+func (x LogFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogFormat.Descriptor instead.
+func (LogFormat) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{7}
+}
+
+type LogLevel int32
+
+const (
+	LogLevel_LOG_LEVEL_UNSPECIFIED LogLevel = 0
+	LogLevel_TRACE                 LogLevel = 1
+	LogLevel_DEBUG                 LogLevel = 2
+	LogLevel_INFO                  LogLevel = 3
+	LogLevel_WARN                  LogLevel = 4
+	LogLevel_ERROR                 LogLevel = 5
+	LogLevel_FATAL                 LogLevel = 6
+)
+
+// Enum value maps for LogLevel.
+var (
+	LogLevel_name = map[int32]string{
+		0: "LOG_LEVEL_UNSPECIFIED",
+		1: "TRACE",
+		2: "DEBUG",
+		3: "INFO",
+		4: "WARN",
+		5: "ERROR",
+		6: "FATAL",
+	}
+	LogLevel_value = map[string]int32{
+		"LOG_LEVEL_UNSPECIFIED": 0,
+		"TRACE":                 1,
+		"DEBUG":                 2,
+		"INFO":                  3,
+		"WARN":                  4,
+		"ERROR":                 5,
+		"FATAL":                 6,
+	}
+)
+
+func (x LogLevel) Enum() *LogLevel {
+	p := new(LogLevel)
+	*p = x
+	return p
+}
+
+func (x LogLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_proto_agent_proto_enumTypes[8].Descriptor()
+}
+
+func (LogLevel) Type() protoreflect.EnumType {
+	return &file_protobuf_proto_agent_proto_enumTypes[8]
+}
+
+func (x LogLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogLevel.Descriptor instead.
+func (LogLevel) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{8}
+}
+
+// Container exec
+type ContainerStream int32
+
+const (
+	ContainerStream_CONTAINER_STREAM_UNSPECIFIED ContainerStream = 0
+	ContainerStream_STDOUT                       ContainerStream = 1
+	ContainerStream_STDERR                       ContainerStream = 2
+)
+
+// Enum value maps for ContainerStream.
+var (
+	ContainerStream_name = map[int32]string{
+		0: "CONTAINER_STREAM_UNSPECIFIED",
+		1: "STDOUT",
+		2: "STDERR",
+	}
+	ContainerStream_value = map[string]int32{
+		"CONTAINER_STREAM_UNSPECIFIED": 0,
+		"STDOUT":                       1,
+		"STDERR":                       2,
+	}
+)
+
+func (x ContainerStream) Enum() *ContainerStream {
+	p := new(ContainerStream)
+	*p = x
+	return p
+}
+
+func (x ContainerStream) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContainerStream) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_proto_agent_proto_enumTypes[9].Descriptor()
+}
+
+func (ContainerStream) Type() protoreflect.EnumType {
+	return &file_protobuf_proto_agent_proto_enumTypes[9]
+}
+
+func (x ContainerStream) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContainerStream.Descriptor instead.
+func (ContainerStream) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{9}
 }
 
-//
 // Common
 type Empty struct {
 	state         protoimpl.MessageState
@@ -220,7 +625,6 @@ func (*Empty) Descriptor() ([]byte, []int) {
 	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{0}
 }
 
-//
 // Agent commands
 type AgentInfo struct {
 	state         protoimpl.MessageState
@@ -283,14 +687,31 @@ type AgentCommand struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Command:
-	//
 	//	*AgentCommand_ContainerState
 	//	*AgentCommand_Close
 	//	*AgentCommand_ContainerCommand
 	//	*AgentCommand_ContainerDelete
 	//	*AgentCommand_ContainerLog
 	//	*AgentCommand_ContainerInspect
+	//	*AgentCommand_ContainerExec
+	//	*AgentCommand_ContainerStats
+	//	*AgentCommand_ImageList
+	//	*AgentCommand_ImageInspect
+	//	*AgentCommand_ImageDelete
+	//	*AgentCommand_ImagePull
+	//	*AgentCommand_VolumeList
+	//	*AgentCommand_VolumeInspect
+	//	*AgentCommand_VolumeDelete
+	//	*AgentCommand_NetworkList
+	//	*AgentCommand_NetworkInspect
+	//	*AgentCommand_NetworkDelete
+	//	*AgentCommand_SystemPrune
+	//	*AgentCommand_ContainerLogExport
+	//	*AgentCommand_StackCommand
+	//	*AgentCommand_ContainerEvents
 	Command isAgentCommand_Command `protobuf_oneof:"command"`
+	// Correlates the command with its CommandResultMessage, no result is sent when empty
+	Id string `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (x *AgentCommand) Reset() {
@@ -374,65 +795,280 @@ func (x *AgentCommand) GetContainerInspect() *ContainerInspectRequest {
 	return nil
 }
 
-type isAgentCommand_Command interface {
-	isAgentCommand_Command()
+func (x *AgentCommand) GetContainerExec() *ContainerExecRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_ContainerExec); ok {
+		return x.ContainerExec
+	}
+	return nil
 }
 
-type AgentCommand_ContainerState struct {
-	ContainerState *ContainerStateRequest `protobuf:"bytes,1,opt,name=containerState,proto3,oneof"`
+func (x *AgentCommand) GetContainerStats() *ContainerStatsRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_ContainerStats); ok {
+		return x.ContainerStats
+	}
+	return nil
 }
 
-type AgentCommand_Close struct {
-	Close *CloseConnectionRequest `protobuf:"bytes,2,opt,name=close,proto3,oneof"`
+func (x *AgentCommand) GetImageList() *ImageListRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_ImageList); ok {
+		return x.ImageList
+	}
+	return nil
 }
 
-type AgentCommand_ContainerCommand struct {
-	ContainerCommand *ContainerCommandRequest `protobuf:"bytes,3,opt,name=containerCommand,proto3,oneof"`
+func (x *AgentCommand) GetImageInspect() *ImageInspectRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_ImageInspect); ok {
+		return x.ImageInspect
+	}
+	return nil
 }
 
-type AgentCommand_ContainerDelete struct {
-	ContainerDelete *ContainerDeleteRequest `protobuf:"bytes,4,opt,name=containerDelete,proto3,oneof"`
+func (x *AgentCommand) GetImageDelete() *ImageDeleteRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_ImageDelete); ok {
+		return x.ImageDelete
+	}
+	return nil
 }
 
-type AgentCommand_ContainerLog struct {
-	ContainerLog *ContainerLogRequest `protobuf:"bytes,5,opt,name=containerLog,proto3,oneof"`
+func (x *AgentCommand) GetImagePull() *ImagePullRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_ImagePull); ok {
+		return x.ImagePull
+	}
+	return nil
 }
 
-type AgentCommand_ContainerInspect struct {
-	ContainerInspect *ContainerInspectRequest `protobuf:"bytes,6,opt,name=containerInspect,proto3,oneof"`
+func (x *AgentCommand) GetVolumeList() *VolumeListRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_VolumeList); ok {
+		return x.VolumeList
+	}
+	return nil
 }
 
-func (*AgentCommand_ContainerState) isAgentCommand_Command() {}
-
-func (*AgentCommand_Close) isAgentCommand_Command() {}
-
-func (*AgentCommand_ContainerCommand) isAgentCommand_Command() {}
-
-func (*AgentCommand_ContainerDelete) isAgentCommand_Command() {}
+func (x *AgentCommand) GetVolumeInspect() *VolumeInspectRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_VolumeInspect); ok {
+		return x.VolumeInspect
+	}
+	return nil
+}
 
-func (*AgentCommand_ContainerLog) isAgentCommand_Command() {}
+func (x *AgentCommand) GetVolumeDelete() *VolumeDeleteRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_VolumeDelete); ok {
+		return x.VolumeDelete
+	}
+	return nil
+}
 
-func (*AgentCommand_ContainerInspect) isAgentCommand_Command() {}
+func (x *AgentCommand) GetNetworkList() *NetworkListRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_NetworkList); ok {
+		return x.NetworkList
+	}
+	return nil
+}
 
-type ContainerStateRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AgentCommand) GetNetworkInspect() *NetworkInspectRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_NetworkInspect); ok {
+		return x.NetworkInspect
+	}
+	return nil
+}
 
-	OneShot *bool `protobuf:"varint,1,opt,name=oneShot,proto3,oneof" json:"oneShot,omitempty"`
+func (x *AgentCommand) GetNetworkDelete() *NetworkDeleteRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_NetworkDelete); ok {
+		return x.NetworkDelete
+	}
+	return nil
 }
 
-func (x *ContainerStateRequest) Reset() {
-	*x = ContainerStateRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AgentCommand) GetSystemPrune() *SystemPruneRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_SystemPrune); ok {
+		return x.SystemPrune
 	}
+	return nil
 }
 
-func (x *ContainerStateRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AgentCommand) GetContainerLogExport() *ContainerLogExportRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_ContainerLogExport); ok {
+		return x.ContainerLogExport
+	}
+	return nil
+}
+
+func (x *AgentCommand) GetStackCommand() *StackCommandRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_StackCommand); ok {
+		return x.StackCommand
+	}
+	return nil
+}
+
+func (x *AgentCommand) GetContainerEvents() *ContainerEventsRequest {
+	if x, ok := x.GetCommand().(*AgentCommand_ContainerEvents); ok {
+		return x.ContainerEvents
+	}
+	return nil
+}
+
+func (x *AgentCommand) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type isAgentCommand_Command interface {
+	isAgentCommand_Command()
+}
+
+type AgentCommand_ContainerState struct {
+	ContainerState *ContainerStateRequest `protobuf:"bytes,1,opt,name=containerState,proto3,oneof"`
+}
+
+type AgentCommand_Close struct {
+	Close *CloseConnectionRequest `protobuf:"bytes,2,opt,name=close,proto3,oneof"`
+}
+
+type AgentCommand_ContainerCommand struct {
+	ContainerCommand *ContainerCommandRequest `protobuf:"bytes,3,opt,name=containerCommand,proto3,oneof"`
+}
+
+type AgentCommand_ContainerDelete struct {
+	ContainerDelete *ContainerDeleteRequest `protobuf:"bytes,4,opt,name=containerDelete,proto3,oneof"`
+}
+
+type AgentCommand_ContainerLog struct {
+	ContainerLog *ContainerLogRequest `protobuf:"bytes,5,opt,name=containerLog,proto3,oneof"`
+}
+
+type AgentCommand_ContainerInspect struct {
+	ContainerInspect *ContainerInspectRequest `protobuf:"bytes,6,opt,name=containerInspect,proto3,oneof"`
+}
+
+type AgentCommand_ContainerExec struct {
+	ContainerExec *ContainerExecRequest `protobuf:"bytes,7,opt,name=containerExec,proto3,oneof"`
+}
+
+type AgentCommand_ContainerStats struct {
+	ContainerStats *ContainerStatsRequest `protobuf:"bytes,8,opt,name=containerStats,proto3,oneof"`
+}
+
+type AgentCommand_ImageList struct {
+	ImageList *ImageListRequest `protobuf:"bytes,9,opt,name=imageList,proto3,oneof"`
+}
+
+type AgentCommand_ImageInspect struct {
+	ImageInspect *ImageInspectRequest `protobuf:"bytes,10,opt,name=imageInspect,proto3,oneof"`
+}
+
+type AgentCommand_ImageDelete struct {
+	ImageDelete *ImageDeleteRequest `protobuf:"bytes,11,opt,name=imageDelete,proto3,oneof"`
+}
+
+type AgentCommand_ImagePull struct {
+	ImagePull *ImagePullRequest `protobuf:"bytes,12,opt,name=imagePull,proto3,oneof"`
+}
+
+type AgentCommand_VolumeList struct {
+	VolumeList *VolumeListRequest `protobuf:"bytes,13,opt,name=volumeList,proto3,oneof"`
+}
+
+type AgentCommand_VolumeInspect struct {
+	VolumeInspect *VolumeInspectRequest `protobuf:"bytes,14,opt,name=volumeInspect,proto3,oneof"`
+}
+
+type AgentCommand_VolumeDelete struct {
+	VolumeDelete *VolumeDeleteRequest `protobuf:"bytes,15,opt,name=volumeDelete,proto3,oneof"`
+}
+
+type AgentCommand_NetworkList struct {
+	NetworkList *NetworkListRequest `protobuf:"bytes,16,opt,name=networkList,proto3,oneof"`
+}
+
+type AgentCommand_NetworkInspect struct {
+	NetworkInspect *NetworkInspectRequest `protobuf:"bytes,17,opt,name=networkInspect,proto3,oneof"`
+}
+
+type AgentCommand_NetworkDelete struct {
+	NetworkDelete *NetworkDeleteRequest `protobuf:"bytes,18,opt,name=networkDelete,proto3,oneof"`
+}
+
+type AgentCommand_SystemPrune struct {
+	SystemPrune *SystemPruneRequest `protobuf:"bytes,19,opt,name=systemPrune,proto3,oneof"`
+}
+
+type AgentCommand_ContainerLogExport struct {
+	ContainerLogExport *ContainerLogExportRequest `protobuf:"bytes,20,opt,name=containerLogExport,proto3,oneof"`
+}
+
+type AgentCommand_StackCommand struct {
+	StackCommand *StackCommandRequest `protobuf:"bytes,21,opt,name=stackCommand,proto3,oneof"`
+}
+
+type AgentCommand_ContainerEvents struct {
+	ContainerEvents *ContainerEventsRequest `protobuf:"bytes,22,opt,name=containerEvents,proto3,oneof"`
+}
+
+func (*AgentCommand_ContainerState) isAgentCommand_Command() {}
+
+func (*AgentCommand_Close) isAgentCommand_Command() {}
+
+func (*AgentCommand_ContainerCommand) isAgentCommand_Command() {}
+
+func (*AgentCommand_ContainerDelete) isAgentCommand_Command() {}
+
+func (*AgentCommand_ContainerLog) isAgentCommand_Command() {}
+
+func (*AgentCommand_ContainerInspect) isAgentCommand_Command() {}
+
+func (*AgentCommand_ContainerExec) isAgentCommand_Command() {}
+
+func (*AgentCommand_ContainerStats) isAgentCommand_Command() {}
+
+func (*AgentCommand_ImageList) isAgentCommand_Command() {}
+
+func (*AgentCommand_ImageInspect) isAgentCommand_Command() {}
+
+func (*AgentCommand_ImageDelete) isAgentCommand_Command() {}
+
+func (*AgentCommand_ImagePull) isAgentCommand_Command() {}
+
+func (*AgentCommand_VolumeList) isAgentCommand_Command() {}
+
+func (*AgentCommand_VolumeInspect) isAgentCommand_Command() {}
+
+func (*AgentCommand_VolumeDelete) isAgentCommand_Command() {}
+
+func (*AgentCommand_NetworkList) isAgentCommand_Command() {}
+
+func (*AgentCommand_NetworkInspect) isAgentCommand_Command() {}
+
+func (*AgentCommand_NetworkDelete) isAgentCommand_Command() {}
+
+func (*AgentCommand_SystemPrune) isAgentCommand_Command() {}
+
+func (*AgentCommand_ContainerLogExport) isAgentCommand_Command() {}
+
+func (*AgentCommand_StackCommand) isAgentCommand_Command() {}
+
+func (*AgentCommand_ContainerEvents) isAgentCommand_Command() {}
+
+type ContainerStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OneShot *bool `protobuf:"varint,1,opt,name=oneShot,proto3,oneof" json:"oneShot,omitempty"`
+}
+
+func (x *ContainerStateRequest) Reset() {
+	*x = ContainerStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*ContainerStateRequest) ProtoMessage() {}
@@ -515,6 +1151,10 @@ type ContainerCommandRequest struct {
 
 	Name      string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Operation ContainerOperation `protobuf:"varint,2,opt,name=operation,proto3,enum=agent.ContainerOperation" json:"operation,omitempty"`
+	// Seconds to wait for stop and restart before killing the container, the runtime default is used when omitted
+	Timeout *int32 `protobuf:"varint,3,opt,name=timeout,proto3,oneof" json:"timeout,omitempty"`
+	// Signal sent by stop, restart and kill (e.g. SIGTERM, SIGKILL, SIGHUP)
+	Signal *string `protobuf:"bytes,4,opt,name=signal,proto3,oneof" json:"signal,omitempty"`
 }
 
 func (x *ContainerCommandRequest) Reset() {
@@ -563,6 +1203,94 @@ func (x *ContainerCommandRequest) GetOperation() ContainerOperation {
 	return ContainerOperation_CONTAINER_OPERATION_UNSPECIFIED
 }
 
+func (x *ContainerCommandRequest) GetTimeout() int32 {
+	if x != nil && x.Timeout != nil {
+		return *x.Timeout
+	}
+	return 0
+}
+
+func (x *ContainerCommandRequest) GetSignal() string {
+	if x != nil && x.Signal != nil {
+		return *x.Signal
+	}
+	return ""
+}
+
+// Executes the operation on every container of a compose project
+type StackCommandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Project   string         `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Operation StackOperation `protobuf:"varint,2,opt,name=operation,proto3,enum=agent.StackOperation" json:"operation,omitempty"`
+	// Seconds to wait for stop and restart before killing the containers, the runtime default is used when omitted
+	Timeout *int32 `protobuf:"varint,3,opt,name=timeout,proto3,oneof" json:"timeout,omitempty"`
+	// Signal sent by stop and restart
+	Signal *string `protobuf:"bytes,4,opt,name=signal,proto3,oneof" json:"signal,omitempty"`
+}
+
+func (x *StackCommandRequest) Reset() {
+	*x = StackCommandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StackCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StackCommandRequest) ProtoMessage() {}
+
+func (x *StackCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StackCommandRequest.ProtoReflect.Descriptor instead.
+func (*StackCommandRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StackCommandRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *StackCommandRequest) GetOperation() StackOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return StackOperation_STACK_OPERATION_UNSPECIFIED
+}
+
+func (x *StackCommandRequest) GetTimeout() int32 {
+	if x != nil && x.Timeout != nil {
+		return *x.Timeout
+	}
+	return 0
+}
+
+func (x *StackCommandRequest) GetSignal() string {
+	if x != nil && x.Signal != nil {
+		return *x.Signal
+	}
+	return ""
+}
+
 type ContainerDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -574,7 +1302,7 @@ type ContainerDeleteRequest struct {
 func (x *ContainerDeleteRequest) Reset() {
 	*x = ContainerDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[6]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -587,7 +1315,7 @@ func (x *ContainerDeleteRequest) String() string {
 func (*ContainerDeleteRequest) ProtoMessage() {}
 
 func (x *ContainerDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[6]
+	mi := &file_protobuf_proto_agent_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -600,7 +1328,7 @@ func (x *ContainerDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerDeleteRequest.ProtoReflect.Descriptor instead.
 func (*ContainerDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{6}
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ContainerDeleteRequest) GetName() string {
@@ -615,15 +1343,34 @@ type ContainerLogRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Streaming bool   `protobuf:"varint,2,opt,name=streaming,proto3" json:"streaming,omitempty"`
-	Tail      uint32 `protobuf:"varint,3,opt,name=tail,proto3" json:"tail,omitempty"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Streaming bool                   `protobuf:"varint,2,opt,name=streaming,proto3" json:"streaming,omitempty"`
+	Tail      uint32                 `protobuf:"varint,3,opt,name=tail,proto3" json:"tail,omitempty"`
+	Since     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	Until     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	// Both streams are sent when unspecified
+	Stream ContainerStream     `protobuf:"varint,6,opt,name=stream,proto3,enum=agent.ContainerStream" json:"stream,omitempty"`
+	Filter *ContainerLogFilter `protobuf:"bytes,7,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	// Parse JSON and logfmt lines into structured logs
+	Parse     bool                   `protobuf:"varint,8,opt,name=parse,proto3" json:"parse,omitempty"`
+	Multiline *ContainerLogMultiline `protobuf:"bytes,9,opt,name=multiline,proto3,oneof" json:"multiline,omitempty"`
+	//
+	// Offset of the last received message of a buffered log, used after reconnecting.
+	// Streaming continues after it without gaps while the agent's buffer holds it.
+	// Aggregated logs are not buffered.
+	ResumeFrom *uint64 `protobuf:"varint,10,opt,name=resumeFrom,proto3,oneof" json:"resumeFrom,omitempty"`
+	// Aggregates the logs of these containers, name is ignored when set
+	Names []string `protobuf:"bytes,11,rep,name=names,proto3" json:"names,omitempty"`
+	// Aggregates the logs of the containers having all of these labels, in 'key' or 'key=value' form
+	Labels []string `protobuf:"bytes,12,rep,name=labels,proto3" json:"labels,omitempty"`
+	// Sends the lines in batches through ContainerLogBatch instead of one by one through ContainerLog
+	Batch bool `protobuf:"varint,13,opt,name=batch,proto3" json:"batch,omitempty"`
 }
 
 func (x *ContainerLogRequest) Reset() {
 	*x = ContainerLogRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[7]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -636,7 +1383,7 @@ func (x *ContainerLogRequest) String() string {
 func (*ContainerLogRequest) ProtoMessage() {}
 
 func (x *ContainerLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[7]
+	mi := &file_protobuf_proto_agent_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,7 +1396,7 @@ func (x *ContainerLogRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerLogRequest.ProtoReflect.Descriptor instead.
 func (*ContainerLogRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{7}
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ContainerLogRequest) GetName() string {
@@ -673,31 +1420,105 @@ func (x *ContainerLogRequest) GetTail() uint32 {
 	return 0
 }
 
-type ContainerInspectRequest struct {
+func (x *ContainerLogRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ContainerLogRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *ContainerLogRequest) GetStream() ContainerStream {
+	if x != nil {
+		return x.Stream
+	}
+	return ContainerStream_CONTAINER_STREAM_UNSPECIFIED
+}
+
+func (x *ContainerLogRequest) GetFilter() *ContainerLogFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ContainerLogRequest) GetParse() bool {
+	if x != nil {
+		return x.Parse
+	}
+	return false
+}
+
+func (x *ContainerLogRequest) GetMultiline() *ContainerLogMultiline {
+	if x != nil {
+		return x.Multiline
+	}
+	return nil
+}
+
+func (x *ContainerLogRequest) GetResumeFrom() uint64 {
+	if x != nil && x.ResumeFrom != nil {
+		return *x.ResumeFrom
+	}
+	return 0
+}
+
+func (x *ContainerLogRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *ContainerLogRequest) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ContainerLogRequest) GetBatch() bool {
+	if x != nil {
+		return x.Batch
+	}
+	return false
+}
+
+// Joins continuation lines (stack traces) with the line before them
+type ContainerLogMultiline struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Regular expression of continuation lines, indented lines are joined when empty
+	Continuation string `protobuf:"bytes,1,opt,name=continuation,proto3" json:"continuation,omitempty"`
+	// Milliseconds to wait for more continuation lines, 500 when unset
+	FlushTimeout uint32 `protobuf:"varint,2,opt,name=flushTimeout,proto3" json:"flushTimeout,omitempty"`
 }
 
-func (x *ContainerInspectRequest) Reset() {
-	*x = ContainerInspectRequest{}
+func (x *ContainerLogMultiline) Reset() {
+	*x = ContainerLogMultiline{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[8]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContainerInspectRequest) String() string {
+func (x *ContainerLogMultiline) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerInspectRequest) ProtoMessage() {}
+func (*ContainerLogMultiline) ProtoMessage() {}
 
-func (x *ContainerInspectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[8]
+func (x *ContainerLogMultiline) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -708,44 +1529,58 @@ func (x *ContainerInspectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerInspectRequest.ProtoReflect.Descriptor instead.
-func (*ContainerInspectRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ContainerLogMultiline.ProtoReflect.Descriptor instead.
+func (*ContainerLogMultiline) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ContainerInspectRequest) GetName() string {
+func (x *ContainerLogMultiline) GetContinuation() string {
 	if x != nil {
-		return x.Name
+		return x.Continuation
 	}
 	return ""
 }
 
-type ContainerStateItemPort struct {
+func (x *ContainerLogMultiline) GetFlushTimeout() uint32 {
+	if x != nil {
+		return x.FlushTimeout
+	}
+	return 0
+}
+
+type ContainerLogFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Internal int32 `protobuf:"varint,1,opt,name=internal,proto3" json:"internal,omitempty"`
-	External int32 `protobuf:"varint,2,opt,name=external,proto3" json:"external,omitempty"`
+	// Substring or regular expression matched against the log line
+	Expression      string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	Regex           bool   `protobuf:"varint,2,opt,name=regex,proto3" json:"regex,omitempty"`
+	CaseInsensitive bool   `protobuf:"varint,3,opt,name=caseInsensitive,proto3" json:"caseInsensitive,omitempty"`
+	// Send the lines not matching the expression
+	Invert bool `protobuf:"varint,4,opt,name=invert,proto3" json:"invert,omitempty"`
+	// Context lines sent before and after each matching line
+	Before uint32 `protobuf:"varint,5,opt,name=before,proto3" json:"before,omitempty"`
+	After  uint32 `protobuf:"varint,6,opt,name=after,proto3" json:"after,omitempty"`
 }
 
-func (x *ContainerStateItemPort) Reset() {
-	*x = ContainerStateItemPort{}
+func (x *ContainerLogFilter) Reset() {
+	*x = ContainerLogFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[9]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContainerStateItemPort) String() string {
+func (x *ContainerLogFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerStateItemPort) ProtoMessage() {}
+func (*ContainerLogFilter) ProtoMessage() {}
 
-func (x *ContainerStateItemPort) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[9]
+func (x *ContainerLogFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,59 +1591,81 @@ func (x *ContainerStateItemPort) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerStateItemPort.ProtoReflect.Descriptor instead.
-func (*ContainerStateItemPort) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ContainerLogFilter.ProtoReflect.Descriptor instead.
+func (*ContainerLogFilter) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ContainerStateItemPort) GetInternal() int32 {
+func (x *ContainerLogFilter) GetExpression() string {
 	if x != nil {
-		return x.Internal
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *ContainerLogFilter) GetRegex() bool {
+	if x != nil {
+		return x.Regex
+	}
+	return false
+}
+
+func (x *ContainerLogFilter) GetCaseInsensitive() bool {
+	if x != nil {
+		return x.CaseInsensitive
+	}
+	return false
+}
+
+func (x *ContainerLogFilter) GetInvert() bool {
+	if x != nil {
+		return x.Invert
+	}
+	return false
+}
+
+func (x *ContainerLogFilter) GetBefore() uint32 {
+	if x != nil {
+		return x.Before
 	}
 	return 0
 }
 
-func (x *ContainerStateItemPort) GetExternal() int32 {
+func (x *ContainerLogFilter) GetAfter() uint32 {
 	if x != nil {
-		return x.External
+		return x.After
 	}
 	return 0
 }
 
-type ContainerStateItem struct {
+// Sends the complete log of the container as a file, without following it
+type ContainerLogExportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Command   string                 `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	// The 'State' of the container (Created, Running, etc)
-	State ContainerState `protobuf:"varint,4,opt,name=state,proto3,enum=agent.ContainerState" json:"state,omitempty"`
-	// The 'reason' behind 'state'.
-	Reason    string                    `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
-	ImageName string                    `protobuf:"bytes,6,opt,name=imageName,proto3" json:"imageName,omitempty"`
-	ImageTag  string                    `protobuf:"bytes,7,opt,name=imageTag,proto3" json:"imageTag,omitempty"`
-	Ports     []*ContainerStateItemPort `protobuf:"bytes,8,rep,name=ports,proto3" json:"ports,omitempty"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Since *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	Until *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3,oneof" json:"until,omitempty"`
 }
 
-func (x *ContainerStateItem) Reset() {
-	*x = ContainerStateItem{}
+func (x *ContainerLogExportRequest) Reset() {
+	*x = ContainerLogExportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[10]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContainerStateItem) String() string {
+func (x *ContainerLogExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerStateItem) ProtoMessage() {}
+func (*ContainerLogExportRequest) ProtoMessage() {}
 
-func (x *ContainerStateItem) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[10]
+func (x *ContainerLogExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -819,92 +1676,210 @@ func (x *ContainerStateItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerStateItem.ProtoReflect.Descriptor instead.
-func (*ContainerStateItem) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ContainerLogExportRequest.ProtoReflect.Descriptor instead.
+func (*ContainerLogExportRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ContainerStateItem) GetName() string {
+func (x *ContainerLogExportRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ContainerStateItem) GetCommand() string {
+func (x *ContainerLogExportRequest) GetSince() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Command
+		return x.Since
 	}
-	return ""
+	return nil
 }
 
-func (x *ContainerStateItem) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ContainerLogExportRequest) GetUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.Until
 	}
 	return nil
 }
 
-func (x *ContainerStateItem) GetState() ContainerState {
+type ContainerInspectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ContainerInspectRequest) Reset() {
+	*x = ContainerInspectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerInspectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerInspectRequest) ProtoMessage() {}
+
+func (x *ContainerInspectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerInspectRequest.ProtoReflect.Descriptor instead.
+func (*ContainerInspectRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ContainerInspectRequest) GetName() string {
 	if x != nil {
-		return x.State
+		return x.Name
 	}
-	return ContainerState_CONTAINER_STATE_UNSPECIFIED
+	return ""
 }
 
-func (x *ContainerStateItem) GetReason() string {
+type ContainerExecRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifies the exec session, sent back as metadata on the exec stream
+	Id         string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Command    []string             `protobuf:"bytes,3,rep,name=command,proto3" json:"command,omitempty"`
+	Tty        bool                 `protobuf:"varint,4,opt,name=tty,proto3" json:"tty,omitempty"`
+	User       *string              `protobuf:"bytes,5,opt,name=user,proto3,oneof" json:"user,omitempty"`
+	WorkingDir *string              `protobuf:"bytes,6,opt,name=workingDir,proto3,oneof" json:"workingDir,omitempty"`
+	Env        []string             `protobuf:"bytes,7,rep,name=env,proto3" json:"env,omitempty"`
+	Size       *ContainerExecResize `protobuf:"bytes,8,opt,name=size,proto3,oneof" json:"size,omitempty"`
+}
+
+func (x *ContainerExecRequest) Reset() {
+	*x = ContainerExecRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerExecRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerExecRequest) ProtoMessage() {}
+
+func (x *ContainerExecRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerExecRequest.ProtoReflect.Descriptor instead.
+func (*ContainerExecRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ContainerExecRequest) GetId() string {
 	if x != nil {
-		return x.Reason
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ContainerStateItem) GetImageName() string {
+func (x *ContainerExecRequest) GetName() string {
 	if x != nil {
-		return x.ImageName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ContainerStateItem) GetImageTag() string {
+func (x *ContainerExecRequest) GetCommand() []string {
 	if x != nil {
-		return x.ImageTag
+		return x.Command
+	}
+	return nil
+}
+
+func (x *ContainerExecRequest) GetTty() bool {
+	if x != nil {
+		return x.Tty
+	}
+	return false
+}
+
+func (x *ContainerExecRequest) GetUser() string {
+	if x != nil && x.User != nil {
+		return *x.User
 	}
 	return ""
 }
 
-func (x *ContainerStateItem) GetPorts() []*ContainerStateItemPort {
+func (x *ContainerExecRequest) GetWorkingDir() string {
+	if x != nil && x.WorkingDir != nil {
+		return *x.WorkingDir
+	}
+	return ""
+}
+
+func (x *ContainerExecRequest) GetEnv() []string {
 	if x != nil {
-		return x.Ports
+		return x.Env
 	}
 	return nil
 }
 
-type ContainerStateListMessage struct {
+func (x *ContainerExecRequest) GetSize() *ContainerExecResize {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+type ContainerStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []*ContainerStateItem `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Seconds between two samples, every sample of the runtime is sent when zero
+	Interval uint32 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
 }
 
-func (x *ContainerStateListMessage) Reset() {
-	*x = ContainerStateListMessage{}
+func (x *ContainerStatsRequest) Reset() {
+	*x = ContainerStatsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[11]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContainerStateListMessage) String() string {
+func (x *ContainerStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerStateListMessage) ProtoMessage() {}
+func (*ContainerStatsRequest) ProtoMessage() {}
 
-func (x *ContainerStateListMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[11]
+func (x *ContainerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -915,45 +1890,57 @@ func (x *ContainerStateListMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerStateListMessage.ProtoReflect.Descriptor instead.
-func (*ContainerStateListMessage) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ContainerStatsRequest.ProtoReflect.Descriptor instead.
+func (*ContainerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ContainerStateListMessage) GetData() []*ContainerStateItem {
+func (x *ContainerStatsRequest) GetName() string {
 	if x != nil {
-		return x.Data
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-//
-// Container log
-type ContainerLogMessage struct {
+func (x *ContainerStatsRequest) GetInterval() uint32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+type ContainerEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Log string `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	// Replays the past events first, the runtime only keeps the most recent ones
+	Since *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	// The stream is closed after reaching it, new events are followed when not set
+	Until *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	// Event types to include (container, image, volume, network, daemon, etc.), all when empty
+	Types []string `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty"`
+	// Name or ID, only the events of this container are sent when set
+	Container *string `protobuf:"bytes,4,opt,name=container,proto3,oneof" json:"container,omitempty"`
 }
 
-func (x *ContainerLogMessage) Reset() {
-	*x = ContainerLogMessage{}
+func (x *ContainerEventsRequest) Reset() {
+	*x = ContainerEventsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[12]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContainerLogMessage) String() string {
+func (x *ContainerEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerLogMessage) ProtoMessage() {}
+func (*ContainerEventsRequest) ProtoMessage() {}
 
-func (x *ContainerLogMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[12]
+func (x *ContainerEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -964,46 +1951,65 @@ func (x *ContainerLogMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerLogMessage.ProtoReflect.Descriptor instead.
-func (*ContainerLogMessage) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ContainerEventsRequest.ProtoReflect.Descriptor instead.
+func (*ContainerEventsRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ContainerLogMessage) GetLog() string {
+func (x *ContainerEventsRequest) GetSince() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Log
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ContainerEventsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *ContainerEventsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ContainerEventsRequest) GetContainer() string {
+	if x != nil && x.Container != nil {
+		return *x.Container
 	}
 	return ""
 }
 
-//
-// Container inspect
-type ContainerInspectMessage struct {
+type ImageListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Inspection string `protobuf:"bytes,2,opt,name=inspection,proto3" json:"inspection,omitempty"`
+	// Include intermediate images
+	All bool `protobuf:"varint,1,opt,name=all,proto3" json:"all,omitempty"`
 }
 
-func (x *ContainerInspectMessage) Reset() {
-	*x = ContainerInspectMessage{}
+func (x *ImageListRequest) Reset() {
+	*x = ImageListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_proto_agent_proto_msgTypes[13]
+		mi := &file_protobuf_proto_agent_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContainerInspectMessage) String() string {
+func (x *ImageListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerInspectMessage) ProtoMessage() {}
+func (*ImageListRequest) ProtoMessage() {}
 
-func (x *ContainerInspectMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_proto_agent_proto_msgTypes[13]
+func (x *ImageListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1014,246 +2020,4724 @@ func (x *ContainerInspectMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerInspectMessage.ProtoReflect.Descriptor instead.
-func (*ContainerInspectMessage) Descriptor() ([]byte, []int) {
-	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ImageListRequest.ProtoReflect.Descriptor instead.
+func (*ImageListRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ContainerInspectMessage) GetName() string {
+func (x *ImageListRequest) GetAll() bool {
 	if x != nil {
-		return x.Name
+		return x.All
 	}
-	return ""
+	return false
 }
 
-func (x *ContainerInspectMessage) GetInspection() string {
-	if x != nil {
-		return x.Inspection
-	}
+type ImageInspectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Image ID or reference
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ImageInspectRequest) Reset() {
+	*x = ImageInspectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageInspectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageInspectRequest) ProtoMessage() {}
+
+func (x *ImageInspectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageInspectRequest.ProtoReflect.Descriptor instead.
+func (*ImageInspectRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ImageInspectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
 	return ""
 }
 
-var File_protobuf_proto_agent_proto protoreflect.FileDescriptor
+type ImageDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_protobuf_proto_agent_proto_rawDesc = []byte{
-	0x0a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x35, 0x0a,
-	0x09, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc1, 0x03, 0x0a, 0x0c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a,
-	0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x05, 0x63,
-	0x6c, 0x6f, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
-	0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x12, 0x49, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x40, 0x0a,
-	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
-	0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x12,
-	0x4c, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x70,
-	0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x42, 0x09, 0x0a,
-	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x42, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x07, 0x6f, 0x6e, 0x65, 0x53, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x6e, 0x65, 0x53, 0x68, 0x6f, 0x74, 0x88, 0x01, 0x01,
-	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f, 0x6e, 0x65, 0x53, 0x68, 0x6f, 0x74, 0x22, 0x44, 0x0a, 0x16,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x22, 0x66, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x37, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x16, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
-	0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x04, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x2d, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x50, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x22, 0xb0, 0x02, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x54, 0x61, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x54, 0x61, 0x67, 0x12, 0x33, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x08, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x50, 0x6f,
-	0x72, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x4a, 0x0a, 0x19, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x27, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x6c, 0x6f, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x22, 0x4d,
-	0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65,
-	0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x57, 0x0a,
-	0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18,
-	0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4c,
-	0x4f, 0x53, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45, 0x4c, 0x46, 0x5f, 0x44, 0x45,
-	0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x48, 0x55, 0x54,
-	0x44, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x2a, 0x79, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x1f,
-	0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41,
-	0x49, 0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x4f, 0x50, 0x5f, 0x43,
-	0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45,
-	0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10,
-	0x03, 0x2a, 0x64, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10,
-	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0a,
-	0x0a, 0x06, 0x45, 0x58, 0x49, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45,
-	0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x04, 0x32, 0xbd, 0x02, 0x0a, 0x05, 0x41, 0x67, 0x65, 0x6e,
-	0x74, 0x12, 0x32, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x10, 0x2e, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x13,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x3e, 0x0a, 0x0f, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x12, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x40, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65,
-	0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x79, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2d, 0x69,
-	0x6f, 0x2f, 0x64, 0x61, 0x72, 0x6b, 0x6c, 0x65, 0x6e, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	// Image ID or reference
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Force bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 }
 
-var (
-	file_protobuf_proto_agent_proto_rawDescOnce sync.Once
-	file_protobuf_proto_agent_proto_rawDescData = file_protobuf_proto_agent_proto_rawDesc
-)
+func (x *ImageDeleteRequest) Reset() {
+	*x = ImageDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_protobuf_proto_agent_proto_rawDescGZIP() []byte {
-	file_protobuf_proto_agent_proto_rawDescOnce.Do(func() {
-		file_protobuf_proto_agent_proto_rawDescData = protoimpl.X.CompressGZIP(file_protobuf_proto_agent_proto_rawDescData)
-	})
-	return file_protobuf_proto_agent_proto_rawDescData
+func (x *ImageDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_protobuf_proto_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_protobuf_proto_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
-var file_protobuf_proto_agent_proto_goTypes = []interface{}{
-	(CloseReason)(0),                  // 0: agent.CloseReason
-	(ContainerOperation)(0),           // 1: agent.ContainerOperation
-	(ContainerState)(0),               // 2: agent.ContainerState
-	(*Empty)(nil),                     // 3: agent.Empty
-	(*AgentInfo)(nil),                 // 4: agent.AgentInfo
-	(*AgentCommand)(nil),              // 5: agent.AgentCommand
-	(*ContainerStateRequest)(nil),     // 6: agent.ContainerStateRequest
-	(*CloseConnectionRequest)(nil),    // 7: agent.CloseConnectionRequest
-	(*ContainerCommandRequest)(nil),   // 8: agent.ContainerCommandRequest
-	(*ContainerDeleteRequest)(nil),    // 9: agent.ContainerDeleteRequest
-	(*ContainerLogRequest)(nil),       // 10: agent.ContainerLogRequest
-	(*ContainerInspectRequest)(nil),   // 11: agent.ContainerInspectRequest
-	(*ContainerStateItemPort)(nil),    // 12: agent.ContainerStateItemPort
-	(*ContainerStateItem)(nil),        // 13: agent.ContainerStateItem
-	(*ContainerStateListMessage)(nil), // 14: agent.ContainerStateListMessage
-	(*ContainerLogMessage)(nil),       // 15: agent.ContainerLogMessage
-	(*ContainerInspectMessage)(nil),   // 16: agent.ContainerInspectMessage
-	(*timestamppb.Timestamp)(nil),     // 17: google.protobuf.Timestamp
+func (*ImageDeleteRequest) ProtoMessage() {}
+
+func (x *ImageDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_protobuf_proto_agent_proto_depIdxs = []int32{
-	6,  // 0: agent.AgentCommand.containerState:type_name -> agent.ContainerStateRequest
-	7,  // 1: agent.AgentCommand.close:type_name -> agent.CloseConnectionRequest
-	8,  // 2: agent.AgentCommand.containerCommand:type_name -> agent.ContainerCommandRequest
-	9,  // 3: agent.AgentCommand.containerDelete:type_name -> agent.ContainerDeleteRequest
-	10, // 4: agent.AgentCommand.containerLog:type_name -> agent.ContainerLogRequest
-	11, // 5: agent.AgentCommand.containerInspect:type_name -> agent.ContainerInspectRequest
-	0,  // 6: agent.CloseConnectionRequest.reason:type_name -> agent.CloseReason
-	1,  // 7: agent.ContainerCommandRequest.operation:type_name -> agent.ContainerOperation
-	17, // 8: agent.ContainerStateItem.createdAt:type_name -> google.protobuf.Timestamp
-	2,  // 9: agent.ContainerStateItem.state:type_name -> agent.ContainerState
-	12, // 10: agent.ContainerStateItem.ports:type_name -> agent.ContainerStateItemPort
-	13, // 11: agent.ContainerStateListMessage.data:type_name -> agent.ContainerStateItem
-	4,  // 12: agent.Agent.Connect:input_type -> agent.AgentInfo
-	14, // 13: agent.Agent.ContainerState:input_type -> agent.ContainerStateListMessage
-	9,  // 14: agent.Agent.DeleteContainer:input_type -> agent.ContainerDeleteRequest
-	15, // 15: agent.Agent.ContainerLog:input_type -> agent.ContainerLogMessage
-	16, // 16: agent.Agent.ContainerInspect:input_type -> agent.ContainerInspectMessage
-	5,  // 17: agent.Agent.Connect:output_type -> agent.AgentCommand
-	3,  // 18: agent.Agent.ContainerState:output_type -> agent.Empty
-	3,  // 19: agent.Agent.DeleteContainer:output_type -> agent.Empty
-	3,  // 20: agent.Agent.ContainerLog:output_type -> agent.Empty
-	3,  // 21: agent.Agent.ContainerInspect:output_type -> agent.Empty
-	17, // [17:22] is the sub-list for method output_type
-	12, // [12:17] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+
+// Deprecated: Use ImageDeleteRequest.ProtoReflect.Descriptor instead.
+func (*ImageDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{18}
 }
 
-func init() { file_protobuf_proto_agent_proto_init() }
-func file_protobuf_proto_agent_proto_init() {
-	if File_protobuf_proto_agent_proto != nil {
-		return
+func (x *ImageDeleteRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_protobuf_proto_agent_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+	return ""
+}
+
+func (x *ImageDeleteRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ImagePullRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Image reference, 'latest' is pulled when the tag is omitted
+	Image string `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	// Base64 encoded registry credentials
+	RegistryAuth *string `protobuf:"bytes,2,opt,name=registryAuth,proto3,oneof" json:"registryAuth,omitempty"`
+	Platform     *string `protobuf:"bytes,3,opt,name=platform,proto3,oneof" json:"platform,omitempty"`
+}
+
+func (x *ImagePullRequest) Reset() {
+	*x = ImagePullRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImagePullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImagePullRequest) ProtoMessage() {}
+
+func (x *ImagePullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImagePullRequest.ProtoReflect.Descriptor instead.
+func (*ImagePullRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ImagePullRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ImagePullRequest) GetRegistryAuth() string {
+	if x != nil && x.RegistryAuth != nil {
+		return *x.RegistryAuth
+	}
+	return ""
+}
+
+func (x *ImagePullRequest) GetPlatform() string {
+	if x != nil && x.Platform != nil {
+		return *x.Platform
+	}
+	return ""
+}
+
+type VolumeListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VolumeListRequest) Reset() {
+	*x = VolumeListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeListRequest) ProtoMessage() {}
+
+func (x *VolumeListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeListRequest.ProtoReflect.Descriptor instead.
+func (*VolumeListRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{20}
+}
+
+type VolumeInspectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *VolumeInspectRequest) Reset() {
+	*x = VolumeInspectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeInspectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeInspectRequest) ProtoMessage() {}
+
+func (x *VolumeInspectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeInspectRequest.ProtoReflect.Descriptor instead.
+func (*VolumeInspectRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *VolumeInspectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type VolumeDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Force bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *VolumeDeleteRequest) Reset() {
+	*x = VolumeDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeDeleteRequest) ProtoMessage() {}
+
+func (x *VolumeDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeDeleteRequest.ProtoReflect.Descriptor instead.
+func (*VolumeDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *VolumeDeleteRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VolumeDeleteRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type NetworkListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NetworkListRequest) Reset() {
+	*x = NetworkListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkListRequest) ProtoMessage() {}
+
+func (x *NetworkListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkListRequest.ProtoReflect.Descriptor instead.
+func (*NetworkListRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{23}
+}
+
+type NetworkInspectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Network ID or name
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *NetworkInspectRequest) Reset() {
+	*x = NetworkInspectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkInspectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInspectRequest) ProtoMessage() {}
+
+func (x *NetworkInspectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkInspectRequest.ProtoReflect.Descriptor instead.
+func (*NetworkInspectRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *NetworkInspectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type NetworkDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Network ID or name
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *NetworkDeleteRequest) Reset() {
+	*x = NetworkDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkDeleteRequest) ProtoMessage() {}
+
+func (x *NetworkDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkDeleteRequest.ProtoReflect.Descriptor instead.
+func (*NetworkDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *NetworkDeleteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SystemPruneRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Stopped containers
+	Containers bool `protobuf:"varint,1,opt,name=containers,proto3" json:"containers,omitempty"`
+	// Untagged images without containers
+	DanglingImages bool `protobuf:"varint,2,opt,name=danglingImages,proto3" json:"danglingImages,omitempty"`
+	// Every image without containers, includes the dangling ones
+	UnusedImages bool `protobuf:"varint,3,opt,name=unusedImages,proto3" json:"unusedImages,omitempty"`
+	// Volumes not mounted by any container
+	Volumes bool `protobuf:"varint,4,opt,name=volumes,proto3" json:"volumes,omitempty"`
+	// Custom networks without containers
+	Networks   bool `protobuf:"varint,5,opt,name=networks,proto3" json:"networks,omitempty"`
+	BuildCache bool `protobuf:"varint,6,opt,name=buildCache,proto3" json:"buildCache,omitempty"`
+	// Report what would be removed without removing anything
+	DryRun bool `protobuf:"varint,7,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (x *SystemPruneRequest) Reset() {
+	*x = SystemPruneRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemPruneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemPruneRequest) ProtoMessage() {}
+
+func (x *SystemPruneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemPruneRequest.ProtoReflect.Descriptor instead.
+func (*SystemPruneRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SystemPruneRequest) GetContainers() bool {
+	if x != nil {
+		return x.Containers
+	}
+	return false
+}
+
+func (x *SystemPruneRequest) GetDanglingImages() bool {
+	if x != nil {
+		return x.DanglingImages
+	}
+	return false
+}
+
+func (x *SystemPruneRequest) GetUnusedImages() bool {
+	if x != nil {
+		return x.UnusedImages
+	}
+	return false
+}
+
+func (x *SystemPruneRequest) GetVolumes() bool {
+	if x != nil {
+		return x.Volumes
+	}
+	return false
+}
+
+func (x *SystemPruneRequest) GetNetworks() bool {
+	if x != nil {
+		return x.Networks
+	}
+	return false
+}
+
+func (x *SystemPruneRequest) GetBuildCache() bool {
+	if x != nil {
+		return x.BuildCache
+	}
+	return false
+}
+
+func (x *SystemPruneRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type CommandResultMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// Unspecified on success
+	ErrorCode ErrorCode `protobuf:"varint,3,opt,name=errorCode,proto3,enum=agent.ErrorCode" json:"errorCode,omitempty"`
+	Message   string    `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CommandResultMessage) Reset() {
+	*x = CommandResultMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandResultMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandResultMessage) ProtoMessage() {}
+
+func (x *CommandResultMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandResultMessage.ProtoReflect.Descriptor instead.
+func (*CommandResultMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CommandResultMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CommandResultMessage) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CommandResultMessage) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+func (x *CommandResultMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ContainerStateItemPort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Internal int32 `protobuf:"varint,1,opt,name=internal,proto3" json:"internal,omitempty"`
+	External int32 `protobuf:"varint,2,opt,name=external,proto3" json:"external,omitempty"`
+	// tcp, udp or sctp
+	Protocol string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// The host interface of the external port
+	HostIp string `protobuf:"bytes,4,opt,name=hostIp,proto3" json:"hostIp,omitempty"`
+}
+
+func (x *ContainerStateItemPort) Reset() {
+	*x = ContainerStateItemPort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerStateItemPort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerStateItemPort) ProtoMessage() {}
+
+func (x *ContainerStateItemPort) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerStateItemPort.ProtoReflect.Descriptor instead.
+func (*ContainerStateItemPort) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ContainerStateItemPort) GetInternal() int32 {
+	if x != nil {
+		return x.Internal
+	}
+	return 0
+}
+
+func (x *ContainerStateItemPort) GetExternal() int32 {
+	if x != nil {
+		return x.External
+	}
+	return 0
+}
+
+func (x *ContainerStateItemPort) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *ContainerStateItemPort) GetHostIp() string {
+	if x != nil {
+		return x.HostIp
+	}
+	return ""
+}
+
+type ContainerStateItemNetwork struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IpAddress   string `protobuf:"bytes,2,opt,name=ipAddress,proto3" json:"ipAddress,omitempty"`
+	Ipv6Address string `protobuf:"bytes,3,opt,name=ipv6Address,proto3" json:"ipv6Address,omitempty"`
+}
+
+func (x *ContainerStateItemNetwork) Reset() {
+	*x = ContainerStateItemNetwork{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerStateItemNetwork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerStateItemNetwork) ProtoMessage() {}
+
+func (x *ContainerStateItemNetwork) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerStateItemNetwork.ProtoReflect.Descriptor instead.
+func (*ContainerStateItemNetwork) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ContainerStateItemNetwork) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContainerStateItemNetwork) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *ContainerStateItemNetwork) GetIpv6Address() string {
+	if x != nil {
+		return x.Ipv6Address
+	}
+	return ""
+}
+
+type ContainerStateItemMount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// bind, volume, tmpfs, etc.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Name of the volume
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Source      string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Destination string `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	ReadOnly    bool   `protobuf:"varint,5,opt,name=readOnly,proto3" json:"readOnly,omitempty"`
+}
+
+func (x *ContainerStateItemMount) Reset() {
+	*x = ContainerStateItemMount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerStateItemMount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerStateItemMount) ProtoMessage() {}
+
+func (x *ContainerStateItemMount) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerStateItemMount.ProtoReflect.Descriptor instead.
+func (*ContainerStateItemMount) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ContainerStateItemMount) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ContainerStateItemMount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContainerStateItemMount) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ContainerStateItemMount) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *ContainerStateItemMount) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+type ContainerStateItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Command   string                 `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// The 'State' of the container (Created, Running, etc)
+	State ContainerState `protobuf:"varint,4,opt,name=state,proto3,enum=agent.ContainerState" json:"state,omitempty"`
+	// The 'reason' behind 'state'.
+	Reason    string                    `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ImageName string                    `protobuf:"bytes,6,opt,name=imageName,proto3" json:"imageName,omitempty"`
+	ImageTag  string                    `protobuf:"bytes,7,opt,name=imageTag,proto3" json:"imageTag,omitempty"`
+	Ports     []*ContainerStateItemPort `protobuf:"bytes,8,rep,name=ports,proto3" json:"ports,omitempty"`
+	Labels    map[string]string         `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// From the com.docker.compose labels, empty when the container is not part of a compose project
+	ComposeProject string          `protobuf:"bytes,10,opt,name=composeProject,proto3" json:"composeProject,omitempty"`
+	ComposeService string          `protobuf:"bytes,11,opt,name=composeService,proto3" json:"composeService,omitempty"`
+	Id             string          `protobuf:"bytes,12,opt,name=id,proto3" json:"id,omitempty"`
+	Health         ContainerHealth `protobuf:"varint,13,opt,name=health,proto3,enum=agent.ContainerHealth" json:"health,omitempty"`
+	RestartCount   int32           `protobuf:"varint,14,opt,name=restartCount,proto3" json:"restartCount,omitempty"`
+	// Set when the container is not running
+	ExitCode *int32                       `protobuf:"varint,15,opt,name=exitCode,proto3,oneof" json:"exitCode,omitempty"`
+	Networks []*ContainerStateItemNetwork `protobuf:"bytes,16,rep,name=networks,proto3" json:"networks,omitempty"`
+	Mounts   []*ContainerStateItemMount   `protobuf:"bytes,17,rep,name=mounts,proto3" json:"mounts,omitempty"`
+	// The reason of the event is set in the reason field
+	Event ContainerStateEvent `protobuf:"varint,18,opt,name=event,proto3,enum=agent.ContainerStateEvent" json:"event,omitempty"`
+}
+
+func (x *ContainerStateItem) Reset() {
+	*x = ContainerStateItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerStateItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerStateItem) ProtoMessage() {}
+
+func (x *ContainerStateItem) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerStateItem.ProtoReflect.Descriptor instead.
+func (*ContainerStateItem) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ContainerStateItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContainerStateItem) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ContainerStateItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ContainerStateItem) GetState() ContainerState {
+	if x != nil {
+		return x.State
+	}
+	return ContainerState_CONTAINER_STATE_UNSPECIFIED
+}
+
+func (x *ContainerStateItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ContainerStateItem) GetImageName() string {
+	if x != nil {
+		return x.ImageName
+	}
+	return ""
+}
+
+func (x *ContainerStateItem) GetImageTag() string {
+	if x != nil {
+		return x.ImageTag
+	}
+	return ""
+}
+
+func (x *ContainerStateItem) GetPorts() []*ContainerStateItemPort {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *ContainerStateItem) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ContainerStateItem) GetComposeProject() string {
+	if x != nil {
+		return x.ComposeProject
+	}
+	return ""
+}
+
+func (x *ContainerStateItem) GetComposeService() string {
+	if x != nil {
+		return x.ComposeService
+	}
+	return ""
+}
+
+func (x *ContainerStateItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContainerStateItem) GetHealth() ContainerHealth {
+	if x != nil {
+		return x.Health
+	}
+	return ContainerHealth_CONTAINER_HEALTH_UNSPECIFIED
+}
+
+func (x *ContainerStateItem) GetRestartCount() int32 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+func (x *ContainerStateItem) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+func (x *ContainerStateItem) GetNetworks() []*ContainerStateItemNetwork {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+func (x *ContainerStateItem) GetMounts() []*ContainerStateItemMount {
+	if x != nil {
+		return x.Mounts
+	}
+	return nil
+}
+
+func (x *ContainerStateItem) GetEvent() ContainerStateEvent {
+	if x != nil {
+		return x.Event
+	}
+	return ContainerStateEvent_CONTAINER_STATE_EVENT_UNSPECIFIED
+}
+
+type ContainerStateListMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*ContainerStateItem `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// Starts from 1 and increases by one with every message of the stream, a gap means lost updates
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// The data is the complete list of containers, replacing every previous item
+	Snapshot bool `protobuf:"varint,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *ContainerStateListMessage) Reset() {
+	*x = ContainerStateListMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerStateListMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerStateListMessage) ProtoMessage() {}
+
+func (x *ContainerStateListMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerStateListMessage.ProtoReflect.Descriptor instead.
+func (*ContainerStateListMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ContainerStateListMessage) GetData() []*ContainerStateItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ContainerStateListMessage) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ContainerStateListMessage) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
+}
+
+// Container log
+type ContainerLogMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The log line without the timestamp
+	Log       string                 `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3,oneof" json:"timestamp,omitempty"`
+	Stream    ContainerStream        `protobuf:"varint,3,opt,name=stream,proto3,enum=agent.ContainerStream" json:"stream,omitempty"`
+	// Set when parsing was requested and the line is structured
+	Structured *StructuredLog `protobuf:"bytes,4,opt,name=structured,proto3,oneof" json:"structured,omitempty"`
+	// Position in the agent's log buffer, 0 when the log is not buffered
+	Offset uint64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Name of the container, set when the logs of several containers are aggregated
+	Container string `protobuf:"bytes,6,opt,name=container,proto3" json:"container,omitempty"`
+}
+
+func (x *ContainerLogMessage) Reset() {
+	*x = ContainerLogMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerLogMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerLogMessage) ProtoMessage() {}
+
+func (x *ContainerLogMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerLogMessage.ProtoReflect.Descriptor instead.
+func (*ContainerLogMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ContainerLogMessage) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+func (x *ContainerLogMessage) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ContainerLogMessage) GetStream() ContainerStream {
+	if x != nil {
+		return x.Stream
+	}
+	return ContainerStream_CONTAINER_STREAM_UNSPECIFIED
+}
+
+func (x *ContainerLogMessage) GetStructured() *StructuredLog {
+	if x != nil {
+		return x.Structured
+	}
+	return nil
+}
+
+func (x *ContainerLogMessage) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ContainerLogMessage) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+type StructuredLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format  LogFormat `protobuf:"varint,1,opt,name=format,proto3,enum=agent.LogFormat" json:"format,omitempty"`
+	Level   LogLevel  `protobuf:"varint,2,opt,name=level,proto3,enum=agent.LogLevel" json:"level,omitempty"`
+	Message string    `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// The timestamp written by the application
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3,oneof" json:"timestamp,omitempty"`
+	// Every other key, values are kept in their original text form
+	Fields map[string]string `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *StructuredLog) Reset() {
+	*x = StructuredLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StructuredLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StructuredLog) ProtoMessage() {}
+
+func (x *StructuredLog) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StructuredLog.ProtoReflect.Descriptor instead.
+func (*StructuredLog) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *StructuredLog) GetFormat() LogFormat {
+	if x != nil {
+		return x.Format
+	}
+	return LogFormat_LOG_FORMAT_UNSPECIFIED
+}
+
+func (x *StructuredLog) GetLevel() LogLevel {
+	if x != nil {
+		return x.Level
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
+}
+
+func (x *StructuredLog) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StructuredLog) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *StructuredLog) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// Lines are collected until the size limit or the time window of the agent is reached
+type ContainerLogBatchMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logs []*ContainerLogMessage `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (x *ContainerLogBatchMessage) Reset() {
+	*x = ContainerLogBatchMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerLogBatchMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerLogBatchMessage) ProtoMessage() {}
+
+func (x *ContainerLogBatchMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerLogBatchMessage.ProtoReflect.Descriptor instead.
+func (*ContainerLogBatchMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ContainerLogBatchMessage) GetLogs() []*ContainerLogMessage {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+// Container log export
+type ContainerLogExportSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hex encoded SHA-256 of the concatenated chunks
+	Sha256 string `protobuf:"bytes,1,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// Uncompressed log size in bytes
+	Size           uint64    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	CompressedSize uint64    `protobuf:"varint,3,opt,name=compressedSize,proto3" json:"compressedSize,omitempty"`
+	Error          string    `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode      ErrorCode `protobuf:"varint,5,opt,name=errorCode,proto3,enum=agent.ErrorCode" json:"errorCode,omitempty"`
+}
+
+func (x *ContainerLogExportSummary) Reset() {
+	*x = ContainerLogExportSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerLogExportSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerLogExportSummary) ProtoMessage() {}
+
+func (x *ContainerLogExportSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerLogExportSummary.ProtoReflect.Descriptor instead.
+func (*ContainerLogExportSummary) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ContainerLogExportSummary) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *ContainerLogExportSummary) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ContainerLogExportSummary) GetCompressedSize() uint64 {
+	if x != nil {
+		return x.CompressedSize
+	}
+	return 0
+}
+
+func (x *ContainerLogExportSummary) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ContainerLogExportSummary) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+// The chunks of the gzip compressed log, followed by a summary
+type ContainerLogExportMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//	*ContainerLogExportMessage_Chunk
+	//	*ContainerLogExportMessage_Summary
+	Message isContainerLogExportMessage_Message `protobuf_oneof:"message"`
+}
+
+func (x *ContainerLogExportMessage) Reset() {
+	*x = ContainerLogExportMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerLogExportMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerLogExportMessage) ProtoMessage() {}
+
+func (x *ContainerLogExportMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerLogExportMessage.ProtoReflect.Descriptor instead.
+func (*ContainerLogExportMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{37}
+}
+
+func (m *ContainerLogExportMessage) GetMessage() isContainerLogExportMessage_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *ContainerLogExportMessage) GetChunk() []byte {
+	if x, ok := x.GetMessage().(*ContainerLogExportMessage_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ContainerLogExportMessage) GetSummary() *ContainerLogExportSummary {
+	if x, ok := x.GetMessage().(*ContainerLogExportMessage_Summary); ok {
+		return x.Summary
+	}
+	return nil
+}
+
+type isContainerLogExportMessage_Message interface {
+	isContainerLogExportMessage_Message()
+}
+
+type ContainerLogExportMessage_Chunk struct {
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3,oneof"`
+}
+
+type ContainerLogExportMessage_Summary struct {
+	Summary *ContainerLogExportSummary `protobuf:"bytes,2,opt,name=summary,proto3,oneof"`
+}
+
+func (*ContainerLogExportMessage_Chunk) isContainerLogExportMessage_Message() {}
+
+func (*ContainerLogExportMessage_Summary) isContainerLogExportMessage_Message() {}
+
+// Container inspect
+type ContainerInspectMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Inspection string    `protobuf:"bytes,2,opt,name=inspection,proto3" json:"inspection,omitempty"`
+	Error      string    `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode  ErrorCode `protobuf:"varint,4,opt,name=errorCode,proto3,enum=agent.ErrorCode" json:"errorCode,omitempty"`
+}
+
+func (x *ContainerInspectMessage) Reset() {
+	*x = ContainerInspectMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerInspectMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerInspectMessage) ProtoMessage() {}
+
+func (x *ContainerInspectMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerInspectMessage.ProtoReflect.Descriptor instead.
+func (*ContainerInspectMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ContainerInspectMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContainerInspectMessage) GetInspection() string {
+	if x != nil {
+		return x.Inspection
+	}
+	return ""
+}
+
+func (x *ContainerInspectMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ContainerInspectMessage) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+type ContainerExecResize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Width  uint32 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *ContainerExecResize) Reset() {
+	*x = ContainerExecResize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerExecResize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerExecResize) ProtoMessage() {}
+
+func (x *ContainerExecResize) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerExecResize.ProtoReflect.Descriptor instead.
+func (*ContainerExecResize) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ContainerExecResize) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ContainerExecResize) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type ContainerExecOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stream ContainerStream `protobuf:"varint,1,opt,name=stream,proto3,enum=agent.ContainerStream" json:"stream,omitempty"`
+	Data   []byte          `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ContainerExecOutput) Reset() {
+	*x = ContainerExecOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerExecOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerExecOutput) ProtoMessage() {}
+
+func (x *ContainerExecOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerExecOutput.ProtoReflect.Descriptor instead.
+func (*ContainerExecOutput) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ContainerExecOutput) GetStream() ContainerStream {
+	if x != nil {
+		return x.Stream
+	}
+	return ContainerStream_CONTAINER_STREAM_UNSPECIFIED
+}
+
+func (x *ContainerExecOutput) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ContainerExecExit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExitCode int32 `protobuf:"varint,1,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
+}
+
+func (x *ContainerExecExit) Reset() {
+	*x = ContainerExecExit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerExecExit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerExecExit) ProtoMessage() {}
+
+func (x *ContainerExecExit) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerExecExit.ProtoReflect.Descriptor instead.
+func (*ContainerExecExit) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ContainerExecExit) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type ContainerExecMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//	*ContainerExecMessage_Output
+	//	*ContainerExecMessage_Exit
+	Message isContainerExecMessage_Message `protobuf_oneof:"message"`
+}
+
+func (x *ContainerExecMessage) Reset() {
+	*x = ContainerExecMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerExecMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerExecMessage) ProtoMessage() {}
+
+func (x *ContainerExecMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerExecMessage.ProtoReflect.Descriptor instead.
+func (*ContainerExecMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{42}
+}
+
+func (m *ContainerExecMessage) GetMessage() isContainerExecMessage_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *ContainerExecMessage) GetOutput() *ContainerExecOutput {
+	if x, ok := x.GetMessage().(*ContainerExecMessage_Output); ok {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *ContainerExecMessage) GetExit() *ContainerExecExit {
+	if x, ok := x.GetMessage().(*ContainerExecMessage_Exit); ok {
+		return x.Exit
+	}
+	return nil
+}
+
+type isContainerExecMessage_Message interface {
+	isContainerExecMessage_Message()
+}
+
+type ContainerExecMessage_Output struct {
+	Output *ContainerExecOutput `protobuf:"bytes,1,opt,name=output,proto3,oneof"`
+}
+
+type ContainerExecMessage_Exit struct {
+	Exit *ContainerExecExit `protobuf:"bytes,2,opt,name=exit,proto3,oneof"`
+}
+
+func (*ContainerExecMessage_Output) isContainerExecMessage_Message() {}
+
+func (*ContainerExecMessage_Exit) isContainerExecMessage_Message() {}
+
+type ContainerExecInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Input:
+	//	*ContainerExecInput_Stdin
+	//	*ContainerExecInput_Resize
+	//	*ContainerExecInput_CloseStdin
+	Input isContainerExecInput_Input `protobuf_oneof:"input"`
+}
+
+func (x *ContainerExecInput) Reset() {
+	*x = ContainerExecInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerExecInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerExecInput) ProtoMessage() {}
+
+func (x *ContainerExecInput) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerExecInput.ProtoReflect.Descriptor instead.
+func (*ContainerExecInput) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{43}
+}
+
+func (m *ContainerExecInput) GetInput() isContainerExecInput_Input {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (x *ContainerExecInput) GetStdin() []byte {
+	if x, ok := x.GetInput().(*ContainerExecInput_Stdin); ok {
+		return x.Stdin
+	}
+	return nil
+}
+
+func (x *ContainerExecInput) GetResize() *ContainerExecResize {
+	if x, ok := x.GetInput().(*ContainerExecInput_Resize); ok {
+		return x.Resize
+	}
+	return nil
+}
+
+func (x *ContainerExecInput) GetCloseStdin() bool {
+	if x, ok := x.GetInput().(*ContainerExecInput_CloseStdin); ok {
+		return x.CloseStdin
+	}
+	return false
+}
+
+type isContainerExecInput_Input interface {
+	isContainerExecInput_Input()
+}
+
+type ContainerExecInput_Stdin struct {
+	Stdin []byte `protobuf:"bytes,1,opt,name=stdin,proto3,oneof"`
+}
+
+type ContainerExecInput_Resize struct {
+	Resize *ContainerExecResize `protobuf:"bytes,2,opt,name=resize,proto3,oneof"`
+}
+
+type ContainerExecInput_CloseStdin struct {
+	CloseStdin bool `protobuf:"varint,3,opt,name=closeStdin,proto3,oneof"`
+}
+
+func (*ContainerExecInput_Stdin) isContainerExecInput_Input() {}
+
+func (*ContainerExecInput_Resize) isContainerExecInput_Input() {}
+
+func (*ContainerExecInput_CloseStdin) isContainerExecInput_Input() {}
+
+// Container stats
+type ContainerStatsMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CpuPercent    float64                `protobuf:"fixed64,2,opt,name=cpuPercent,proto3" json:"cpuPercent,omitempty"`
+	MemoryUsage   uint64                 `protobuf:"varint,3,opt,name=memoryUsage,proto3" json:"memoryUsage,omitempty"`
+	MemoryLimit   uint64                 `protobuf:"varint,4,opt,name=memoryLimit,proto3" json:"memoryLimit,omitempty"`
+	MemoryPercent float64                `protobuf:"fixed64,5,opt,name=memoryPercent,proto3" json:"memoryPercent,omitempty"`
+	// Network and block I/O bytes since the previous sample, zero in the first one
+	NetworkRx  uint64 `protobuf:"varint,6,opt,name=networkRx,proto3" json:"networkRx,omitempty"`
+	NetworkTx  uint64 `protobuf:"varint,7,opt,name=networkTx,proto3" json:"networkTx,omitempty"`
+	BlockRead  uint64 `protobuf:"varint,8,opt,name=blockRead,proto3" json:"blockRead,omitempty"`
+	BlockWrite uint64 `protobuf:"varint,9,opt,name=blockWrite,proto3" json:"blockWrite,omitempty"`
+	Pids       uint64 `protobuf:"varint,10,opt,name=pids,proto3" json:"pids,omitempty"`
+}
+
+func (x *ContainerStatsMessage) Reset() {
+	*x = ContainerStatsMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerStatsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerStatsMessage) ProtoMessage() {}
+
+func (x *ContainerStatsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerStatsMessage.ProtoReflect.Descriptor instead.
+func (*ContainerStatsMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ContainerStatsMessage) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ContainerStatsMessage) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *ContainerStatsMessage) GetMemoryUsage() uint64 {
+	if x != nil {
+		return x.MemoryUsage
+	}
+	return 0
+}
+
+func (x *ContainerStatsMessage) GetMemoryLimit() uint64 {
+	if x != nil {
+		return x.MemoryLimit
+	}
+	return 0
+}
+
+func (x *ContainerStatsMessage) GetMemoryPercent() float64 {
+	if x != nil {
+		return x.MemoryPercent
+	}
+	return 0
+}
+
+func (x *ContainerStatsMessage) GetNetworkRx() uint64 {
+	if x != nil {
+		return x.NetworkRx
+	}
+	return 0
+}
+
+func (x *ContainerStatsMessage) GetNetworkTx() uint64 {
+	if x != nil {
+		return x.NetworkTx
+	}
+	return 0
+}
+
+func (x *ContainerStatsMessage) GetBlockRead() uint64 {
+	if x != nil {
+		return x.BlockRead
+	}
+	return 0
+}
+
+func (x *ContainerStatsMessage) GetBlockWrite() uint64 {
+	if x != nil {
+		return x.BlockWrite
+	}
+	return 0
+}
+
+func (x *ContainerStatsMessage) GetPids() uint64 {
+	if x != nil {
+		return x.Pids
+	}
+	return 0
+}
+
+// Container events
+type ContainerEventMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// container, image, volume, network, daemon, etc.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Action as reported by the runtime, for example start, die or health_status: healthy
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// ID of the object the event is about
+	ActorId    string            `protobuf:"bytes,4,opt,name=actorId,proto3" json:"actorId,omitempty"`
+	Attributes map[string]string `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// local or swarm
+	Scope string `protobuf:"bytes,6,opt,name=scope,proto3" json:"scope,omitempty"`
+}
+
+func (x *ContainerEventMessage) Reset() {
+	*x = ContainerEventMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerEventMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerEventMessage) ProtoMessage() {}
+
+func (x *ContainerEventMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerEventMessage.ProtoReflect.Descriptor instead.
+func (*ContainerEventMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ContainerEventMessage) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ContainerEventMessage) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ContainerEventMessage) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ContainerEventMessage) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ContainerEventMessage) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *ContainerEventMessage) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+// Node info
+type NodeDiskUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Images     int64 `protobuf:"varint,1,opt,name=images,proto3" json:"images,omitempty"`
+	Containers int64 `protobuf:"varint,2,opt,name=containers,proto3" json:"containers,omitempty"`
+	Volumes    int64 `protobuf:"varint,3,opt,name=volumes,proto3" json:"volumes,omitempty"`
+	BuildCache int64 `protobuf:"varint,4,opt,name=buildCache,proto3" json:"buildCache,omitempty"`
+	Total      int64 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *NodeDiskUsage) Reset() {
+	*x = NodeDiskUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeDiskUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeDiskUsage) ProtoMessage() {}
+
+func (x *NodeDiskUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeDiskUsage.ProtoReflect.Descriptor instead.
+func (*NodeDiskUsage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *NodeDiskUsage) GetImages() int64 {
+	if x != nil {
+		return x.Images
+	}
+	return 0
+}
+
+func (x *NodeDiskUsage) GetContainers() int64 {
+	if x != nil {
+		return x.Containers
+	}
+	return 0
+}
+
+func (x *NodeDiskUsage) GetVolumes() int64 {
+	if x != nil {
+		return x.Volumes
+	}
+	return 0
+}
+
+func (x *NodeDiskUsage) GetBuildCache() int64 {
+	if x != nil {
+		return x.BuildCache
+	}
+	return 0
+}
+
+func (x *NodeDiskUsage) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type NodeInfoMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// docker, podman or unknown-runtime
+	Runtime         string `protobuf:"bytes,1,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	RuntimeVersion  string `protobuf:"bytes,2,opt,name=runtimeVersion,proto3" json:"runtimeVersion,omitempty"`
+	Hostname        string `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	OperatingSystem string `protobuf:"bytes,4,opt,name=operatingSystem,proto3" json:"operatingSystem,omitempty"`
+	OsType          string `protobuf:"bytes,5,opt,name=osType,proto3" json:"osType,omitempty"`
+	KernelVersion   string `protobuf:"bytes,6,opt,name=kernelVersion,proto3" json:"kernelVersion,omitempty"`
+	Architecture    string `protobuf:"bytes,7,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	CpuCount        int32  `protobuf:"varint,8,opt,name=cpuCount,proto3" json:"cpuCount,omitempty"`
+	MemoryTotal     int64  `protobuf:"varint,9,opt,name=memoryTotal,proto3" json:"memoryTotal,omitempty"`
+	DockerRootDir   string `protobuf:"bytes,10,opt,name=dockerRootDir,proto3" json:"dockerRootDir,omitempty"`
+	// Not set when the runtime fails to calculate it, the object types left out by the agent are zero
+	DiskUsage      *NodeDiskUsage `protobuf:"bytes,11,opt,name=diskUsage,proto3" json:"diskUsage,omitempty"`
+	ImageCount     int32          `protobuf:"varint,12,opt,name=imageCount,proto3" json:"imageCount,omitempty"`
+	VolumeCount    int32          `protobuf:"varint,13,opt,name=volumeCount,proto3" json:"volumeCount,omitempty"`
+	NetworkCount   int32          `protobuf:"varint,14,opt,name=networkCount,proto3" json:"networkCount,omitempty"`
+	ContainerCount int32          `protobuf:"varint,15,opt,name=containerCount,proto3" json:"containerCount,omitempty"`
+}
+
+func (x *NodeInfoMessage) Reset() {
+	*x = NodeInfoMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeInfoMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeInfoMessage) ProtoMessage() {}
+
+func (x *NodeInfoMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeInfoMessage.ProtoReflect.Descriptor instead.
+func (*NodeInfoMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *NodeInfoMessage) GetRuntime() string {
+	if x != nil {
+		return x.Runtime
+	}
+	return ""
+}
+
+func (x *NodeInfoMessage) GetRuntimeVersion() string {
+	if x != nil {
+		return x.RuntimeVersion
+	}
+	return ""
+}
+
+func (x *NodeInfoMessage) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *NodeInfoMessage) GetOperatingSystem() string {
+	if x != nil {
+		return x.OperatingSystem
+	}
+	return ""
+}
+
+func (x *NodeInfoMessage) GetOsType() string {
+	if x != nil {
+		return x.OsType
+	}
+	return ""
+}
+
+func (x *NodeInfoMessage) GetKernelVersion() string {
+	if x != nil {
+		return x.KernelVersion
+	}
+	return ""
+}
+
+func (x *NodeInfoMessage) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *NodeInfoMessage) GetCpuCount() int32 {
+	if x != nil {
+		return x.CpuCount
+	}
+	return 0
+}
+
+func (x *NodeInfoMessage) GetMemoryTotal() int64 {
+	if x != nil {
+		return x.MemoryTotal
+	}
+	return 0
+}
+
+func (x *NodeInfoMessage) GetDockerRootDir() string {
+	if x != nil {
+		return x.DockerRootDir
+	}
+	return ""
+}
+
+func (x *NodeInfoMessage) GetDiskUsage() *NodeDiskUsage {
+	if x != nil {
+		return x.DiskUsage
+	}
+	return nil
+}
+
+func (x *NodeInfoMessage) GetImageCount() int32 {
+	if x != nil {
+		return x.ImageCount
+	}
+	return 0
+}
+
+func (x *NodeInfoMessage) GetVolumeCount() int32 {
+	if x != nil {
+		return x.VolumeCount
+	}
+	return 0
+}
+
+func (x *NodeInfoMessage) GetNetworkCount() int32 {
+	if x != nil {
+		return x.NetworkCount
+	}
+	return 0
+}
+
+func (x *NodeInfoMessage) GetContainerCount() int32 {
+	if x != nil {
+		return x.ContainerCount
+	}
+	return 0
+}
+
+// Images
+type ImageItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tags      []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Digests   []string               `protobuf:"bytes,3,rep,name=digests,proto3" json:"digests,omitempty"`
+	Size      int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// The image has no tags
+	Dangling bool `protobuf:"varint,6,opt,name=dangling,proto3" json:"dangling,omitempty"`
+	// Names of the containers using the image
+	Containers []string `protobuf:"bytes,7,rep,name=containers,proto3" json:"containers,omitempty"`
+}
+
+func (x *ImageItem) Reset() {
+	*x = ImageItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageItem) ProtoMessage() {}
+
+func (x *ImageItem) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageItem.ProtoReflect.Descriptor instead.
+func (*ImageItem) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ImageItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImageItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ImageItem) GetDigests() []string {
+	if x != nil {
+		return x.Digests
+	}
+	return nil
+}
+
+func (x *ImageItem) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ImageItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ImageItem) GetDangling() bool {
+	if x != nil {
+		return x.Dangling
+	}
+	return false
+}
+
+func (x *ImageItem) GetContainers() []string {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+type ImageListMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data      []*ImageItem `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Error     string       `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode ErrorCode    `protobuf:"varint,3,opt,name=errorCode,proto3,enum=agent.ErrorCode" json:"errorCode,omitempty"`
+}
+
+func (x *ImageListMessage) Reset() {
+	*x = ImageListMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageListMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageListMessage) ProtoMessage() {}
+
+func (x *ImageListMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageListMessage.ProtoReflect.Descriptor instead.
+func (*ImageListMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ImageListMessage) GetData() []*ImageItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImageListMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImageListMessage) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+type ImageInspectMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Inspection string    `protobuf:"bytes,2,opt,name=inspection,proto3" json:"inspection,omitempty"`
+	Error      string    `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode  ErrorCode `protobuf:"varint,4,opt,name=errorCode,proto3,enum=agent.ErrorCode" json:"errorCode,omitempty"`
+}
+
+func (x *ImageInspectMessage) Reset() {
+	*x = ImageInspectMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageInspectMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageInspectMessage) ProtoMessage() {}
+
+func (x *ImageInspectMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageInspectMessage.ProtoReflect.Descriptor instead.
+func (*ImageInspectMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ImageInspectMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImageInspectMessage) GetInspection() string {
+	if x != nil {
+		return x.Inspection
+	}
+	return ""
+}
+
+func (x *ImageInspectMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImageInspectMessage) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+type ImagePullMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The layer the status belongs to
+	LayerId string `protobuf:"bytes,2,opt,name=layerId,proto3" json:"layerId,omitempty"`
+	Current int64  `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`
+	Total   int64  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Error   string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// Unspecified unless error is set
+	ErrorCode ErrorCode `protobuf:"varint,6,opt,name=errorCode,proto3,enum=agent.ErrorCode" json:"errorCode,omitempty"`
+}
+
+func (x *ImagePullMessage) Reset() {
+	*x = ImagePullMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImagePullMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImagePullMessage) ProtoMessage() {}
+
+func (x *ImagePullMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImagePullMessage.ProtoReflect.Descriptor instead.
+func (*ImagePullMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ImagePullMessage) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImagePullMessage) GetLayerId() string {
+	if x != nil {
+		return x.LayerId
+	}
+	return ""
+}
+
+func (x *ImagePullMessage) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *ImagePullMessage) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImagePullMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImagePullMessage) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+// Volumes
+type VolumeItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Driver     string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
+	Mountpoint string                 `protobuf:"bytes,3,opt,name=mountpoint,proto3" json:"mountpoint,omitempty"`
+	Scope      string                 `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Labels     map[string]string      `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Names of the containers mounting the volume
+	Containers []string `protobuf:"bytes,7,rep,name=containers,proto3" json:"containers,omitempty"`
+}
+
+func (x *VolumeItem) Reset() {
+	*x = VolumeItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeItem) ProtoMessage() {}
+
+func (x *VolumeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeItem.ProtoReflect.Descriptor instead.
+func (*VolumeItem) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *VolumeItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VolumeItem) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *VolumeItem) GetMountpoint() string {
+	if x != nil {
+		return x.Mountpoint
+	}
+	return ""
+}
+
+func (x *VolumeItem) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *VolumeItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *VolumeItem) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *VolumeItem) GetContainers() []string {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+type VolumeListMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data      []*VolumeItem `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Error     string        `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode ErrorCode     `protobuf:"varint,3,opt,name=errorCode,proto3,enum=agent.ErrorCode" json:"errorCode,omitempty"`
+}
+
+func (x *VolumeListMessage) Reset() {
+	*x = VolumeListMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeListMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeListMessage) ProtoMessage() {}
+
+func (x *VolumeListMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeListMessage.ProtoReflect.Descriptor instead.
+func (*VolumeListMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *VolumeListMessage) GetData() []*VolumeItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *VolumeListMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *VolumeListMessage) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+type VolumeInspectMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Inspection string    `protobuf:"bytes,2,opt,name=inspection,proto3" json:"inspection,omitempty"`
+	Error      string    `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode  ErrorCode `protobuf:"varint,4,opt,name=errorCode,proto3,enum=agent.ErrorCode" json:"errorCode,omitempty"`
+}
+
+func (x *VolumeInspectMessage) Reset() {
+	*x = VolumeInspectMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeInspectMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeInspectMessage) ProtoMessage() {}
+
+func (x *VolumeInspectMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeInspectMessage.ProtoReflect.Descriptor instead.
+func (*VolumeInspectMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *VolumeInspectMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VolumeInspectMessage) GetInspection() string {
+	if x != nil {
+		return x.Inspection
+	}
+	return ""
+}
+
+func (x *VolumeInspectMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *VolumeInspectMessage) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+// Networks
+type NetworkItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Driver    string                 `protobuf:"bytes,3,opt,name=driver,proto3" json:"driver,omitempty"`
+	Scope     string                 `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	Internal  bool                   `protobuf:"varint,5,opt,name=internal,proto3" json:"internal,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Labels    map[string]string      `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Subnets   []string               `protobuf:"bytes,8,rep,name=subnets,proto3" json:"subnets,omitempty"`
+	// Names of the containers attached to the network
+	Containers []string `protobuf:"bytes,9,rep,name=containers,proto3" json:"containers,omitempty"`
+}
+
+func (x *NetworkItem) Reset() {
+	*x = NetworkItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkItem) ProtoMessage() {}
+
+func (x *NetworkItem) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkItem.ProtoReflect.Descriptor instead.
+func (*NetworkItem) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *NetworkItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NetworkItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetworkItem) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *NetworkItem) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *NetworkItem) GetInternal() bool {
+	if x != nil {
+		return x.Internal
+	}
+	return false
+}
+
+func (x *NetworkItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NetworkItem) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *NetworkItem) GetSubnets() []string {
+	if x != nil {
+		return x.Subnets
+	}
+	return nil
+}
+
+func (x *NetworkItem) GetContainers() []string {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+type NetworkListMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data      []*NetworkItem `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Error     string         `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode ErrorCode      `protobuf:"varint,3,opt,name=errorCode,proto3,enum=agent.ErrorCode" json:"errorCode,omitempty"`
+}
+
+func (x *NetworkListMessage) Reset() {
+	*x = NetworkListMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkListMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkListMessage) ProtoMessage() {}
+
+func (x *NetworkListMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkListMessage.ProtoReflect.Descriptor instead.
+func (*NetworkListMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *NetworkListMessage) GetData() []*NetworkItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *NetworkListMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *NetworkListMessage) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+type NetworkInspectMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Inspection string    `protobuf:"bytes,2,opt,name=inspection,proto3" json:"inspection,omitempty"`
+	Error      string    `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode  ErrorCode `protobuf:"varint,4,opt,name=errorCode,proto3,enum=agent.ErrorCode" json:"errorCode,omitempty"`
+}
+
+func (x *NetworkInspectMessage) Reset() {
+	*x = NetworkInspectMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkInspectMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInspectMessage) ProtoMessage() {}
+
+func (x *NetworkInspectMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkInspectMessage.ProtoReflect.Descriptor instead.
+func (*NetworkInspectMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *NetworkInspectMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NetworkInspectMessage) GetInspection() string {
+	if x != nil {
+		return x.Inspection
+	}
+	return ""
+}
+
+func (x *NetworkInspectMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *NetworkInspectMessage) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+// System prune
+type SystemPruneItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Estimated bytes freed by removing the item
+	Size uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *SystemPruneItem) Reset() {
+	*x = SystemPruneItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemPruneItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemPruneItem) ProtoMessage() {}
+
+func (x *SystemPruneItem) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemPruneItem.ProtoReflect.Descriptor instead.
+func (*SystemPruneItem) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SystemPruneItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SystemPruneItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemPruneItem) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type SystemPruneMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DryRun     bool               `protobuf:"varint,1,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	Containers []*SystemPruneItem `protobuf:"bytes,2,rep,name=containers,proto3" json:"containers,omitempty"`
+	Images     []*SystemPruneItem `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
+	Volumes    []*SystemPruneItem `protobuf:"bytes,4,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	Networks   []*SystemPruneItem `protobuf:"bytes,5,rep,name=networks,proto3" json:"networks,omitempty"`
+	BuildCache []*SystemPruneItem `protobuf:"bytes,6,rep,name=buildCache,proto3" json:"buildCache,omitempty"`
+	// Freed bytes, or the estimated amount in dry-run mode
+	SpaceReclaimed uint64    `protobuf:"varint,7,opt,name=spaceReclaimed,proto3" json:"spaceReclaimed,omitempty"`
+	Error          string    `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode      ErrorCode `protobuf:"varint,9,opt,name=errorCode,proto3,enum=agent.ErrorCode" json:"errorCode,omitempty"`
+}
+
+func (x *SystemPruneMessage) Reset() {
+	*x = SystemPruneMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_proto_agent_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemPruneMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemPruneMessage) ProtoMessage() {}
+
+func (x *SystemPruneMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_proto_agent_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemPruneMessage.ProtoReflect.Descriptor instead.
+func (*SystemPruneMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_proto_agent_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SystemPruneMessage) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *SystemPruneMessage) GetContainers() []*SystemPruneItem {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+func (x *SystemPruneMessage) GetImages() []*SystemPruneItem {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *SystemPruneMessage) GetVolumes() []*SystemPruneItem {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *SystemPruneMessage) GetNetworks() []*SystemPruneItem {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+func (x *SystemPruneMessage) GetBuildCache() []*SystemPruneItem {
+	if x != nil {
+		return x.BuildCache
+	}
+	return nil
+}
+
+func (x *SystemPruneMessage) GetSpaceReclaimed() uint64 {
+	if x != nil {
+		return x.SpaceReclaimed
+	}
+	return 0
+}
+
+func (x *SystemPruneMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SystemPruneMessage) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+var File_protobuf_proto_agent_proto protoreflect.FileDescriptor
+
+var file_protobuf_proto_agent_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x35, 0x0a,
+	0x09, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x80, 0x0c, 0x0a, 0x0c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a,
+	0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x05, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x12, 0x49, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x40, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x12,
+	0x4c, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x43, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x78, 0x65, 0x63, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x78,
+	0x65, 0x63, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x6e,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x6c,
+	0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x12, 0x3a, 0x0a,
+	0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x0d, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x40,
+	0x0a, 0x0c, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x0c, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x12, 0x3d, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x46, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0b,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x50, 0x72, 0x75, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x12, 0x52, 0x0a, 0x12, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x40, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74,
+	0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x12, 0x49, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x42, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x07, 0x6f, 0x6e, 0x65, 0x53, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x6e, 0x65, 0x53, 0x68, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x6f, 0x6e, 0x65, 0x53, 0x68, 0x6f, 0x74, 0x22, 0x44, 0x0a, 0x16, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0xb9, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x37, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x22, 0xb7, 0x01,
+	0x0a, 0x13, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x33, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x63, 0x6b,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x88, 0x01, 0x01,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x22, 0x2c, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xad, 0x04, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74,
+	0x61, 0x69, 0x6c, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00,
+	0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x05, 0x75, 0x6e,
+	0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x02, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72,
+	0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x70, 0x61, 0x72, 0x73, 0x65, 0x12,
+	0x3f, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6c, 0x69, 0x6e, 0x65,
+	0x48, 0x03, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x6c, 0x69, 0x6e, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x23, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x46, 0x72,
+	0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x5f, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65,
+	0x67, 0x65, 0x78, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x61,
+	0x73, 0x65, 0x49, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x22, 0xb1, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x4c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x00, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x05,
+	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x2d, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x8c, 0x02, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x74, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x74, 0x74, 0x79, 0x12,
+	0x17, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a,
+	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x88, 0x01, 0x01, 0x12, 0x10, 0x0a,
+	0x03, 0x65, 0x6e, 0x76, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12,
+	0x33, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45,
+	0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x48, 0x02, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x47, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0xe1,
+	0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x35, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x05, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x88, 0x01, 0x01,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x22, 0x24, 0x0a, 0x10, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x22, 0x25, 0x0a, 0x13, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x3a, 0x0a, 0x12, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x10,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12,
+	0x1f, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x88, 0x01, 0x01,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x41, 0x75, 0x74,
+	0x68, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0x13,
+	0x0a, 0x11, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x14, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x3f, 0x0a, 0x13, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x22, 0x14, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x27, 0x0a, 0x15, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x26, 0x0a, 0x14, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xee, 0x01, 0x0a, 0x12, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x26,
+	0x0a, 0x0e, 0x64, 0x61, 0x6e, 0x67, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x61, 0x6e, 0x67, 0x6c, 0x69, 0x6e, 0x67,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x75, 0x6e,
+	0x75, 0x73, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x8a, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x50, 0x6f, 0x72, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x22, 0x6f, 0x0a, 0x19,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69,
+	0x70, 0x76, 0x36, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x69, 0x70, 0x76, 0x36, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x97, 0x01,
+	0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72,
+	0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xb4, 0x06, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x54, 0x61, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x54, 0x61, 0x67, 0x12, 0x33, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x50,
+	0x6f, 0x72, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a,
+	0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x00, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3c,
+	0x0a, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x52, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x36, 0x0a, 0x06,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x82,
+	0x01, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x22, 0xa4, 0x02, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6c,
+	0x6f, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x3d, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x06,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x48, 0x01, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x22, 0xbc, 0x02, 0x0a, 0x0d, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x12, 0x28, 0x0a, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x4a, 0x0a, 0x18, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2e, 0x0a,
+	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x7c, 0x0a,
+	0x19, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x17,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x2e, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0x43, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x78,
+	0x65, 0x63, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x59, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x45, 0x78, 0x65, 0x63, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2e, 0x0a,
+	0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x2f, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x78,
+	0x65, 0x63, 0x45, 0x78, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x45, 0x78, 0x65, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x78, 0x65,
+	0x63, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x2e, 0x0a, 0x04, 0x65, 0x78, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x45, 0x78, 0x65, 0x63, 0x45, 0x78, 0x69, 0x74, 0x48, 0x00, 0x52, 0x04, 0x65, 0x78, 0x69,
+	0x74, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8d, 0x01, 0x0a,
+	0x12, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x78, 0x65, 0x63, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x12, 0x34, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x78, 0x65,
+	0x63, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x20, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x74, 0x64, 0x69, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x74,
+	0x64, 0x69, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0xe9, 0x02, 0x0a,
+	0x15, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x54, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x54, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x61, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x70, 0x69, 0x64, 0x73, 0x22, 0xba, 0x02, 0x0a, 0x15, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x4c, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x97, 0x01, 0x0a, 0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x69,
+	0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22,
+	0xa1, 0x04, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x73, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x73, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x65, 0x72, 0x6e,
+	0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x63, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x64,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x74, 0x44, 0x69, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x74, 0x44, 0x69,
+	0x72, 0x12, 0x32, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6b,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xd3, 0x01, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x61, 0x6e, 0x67, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x64, 0x61, 0x6e, 0x67, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x7e, 0x0a, 0x10, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x09, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x13, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x10, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x50, 0x75, 0x6c, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x22, 0xba, 0x02, 0x0a, 0x0a, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x35, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x80, 0x01, 0x0a, 0x11, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x14, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xe2, 0x02, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x36, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49,
+	0x74, 0x65, 0x6d, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6e, 0x65,
+	0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x82, 0x01, 0x0a,
+	0x12, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x2e, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0x8d, 0x01, 0x0a, 0x15, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x2e, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0x49, 0x0a, 0x0f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xa0, 0x03, 0x0a,
+	0x12, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x36, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72,
+	0x75, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x75, 0x6e,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x2e, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x2a,
+	0x57, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x18, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45, 0x4c, 0x46, 0x5f,
+	0x44, 0x45, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x48,
+	0x55, 0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x2a, 0xb9, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x23, 0x0a, 0x1f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x43, 0x4f,
+	0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x4f,
+	0x50, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x02, 0x12, 0x15, 0x0a,
+	0x11, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
+	0x45, 0x52, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x43, 0x4f,
+	0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x4e, 0x50,
+	0x41, 0x55, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x05,
+	0x12, 0x12, 0x0a, 0x0e, 0x4b, 0x49, 0x4c, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
+	0x45, 0x52, 0x10, 0x06, 0x2a, 0x77, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x54, 0x41, 0x43, 0x4b, 0x5f,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x54, 0x41, 0x52, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x4f, 0x50,
+	0x5f, 0x53, 0x54, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x53, 0x54,
+	0x41, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x43, 0x4b, 0x10, 0x04, 0x2a, 0xd0, 0x01,
+	0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f,
+	0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x04, 0x12, 0x15,
+	0x0a, 0x11, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e,
+	0x49, 0x45, 0x44, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x55, 0x4e, 0x54, 0x49, 0x4d, 0x45,
+	0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x06, 0x12, 0x13,
+	0x0a, 0x0f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x45,
+	0x44, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10,
+	0x08, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x4d, 0x42, 0x49, 0x47, 0x55, 0x4f, 0x55, 0x53, 0x10, 0x09,
+	0x2a, 0x64, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0a, 0x0a,
+	0x06, 0x45, 0x58, 0x49, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4d,
+	0x4f, 0x56, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x7f, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a,
+	0x21, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x55, 0x54, 0x5f,
+	0x4f, 0x46, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4b,
+	0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x52, 0x41, 0x53, 0x48,
+	0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x10, 0x04, 0x2a, 0x5d, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f,
+	0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x45,
+	0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x48, 0x45, 0x41,
+	0x4c, 0x54, 0x48, 0x59, 0x10, 0x03, 0x2a, 0x3d, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x4f, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x4f, 0x47,
+	0x46, 0x4d, 0x54, 0x10, 0x02, 0x2a, 0x65, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x54, 0x52, 0x41, 0x43, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47,
+	0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04,
+	0x57, 0x41, 0x52, 0x4e, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x05, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x41, 0x54, 0x41, 0x4c, 0x10, 0x06, 0x2a, 0x4b, 0x0a, 0x0f,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x52,
+	0x45, 0x41, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x44, 0x4f, 0x55, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x53, 0x54, 0x44, 0x45, 0x52, 0x52, 0x10, 0x02, 0x32, 0xcb, 0x09, 0x0a, 0x05, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x10,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x1a, 0x13, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x3e, 0x0a, 0x0f, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1d,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0c, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x12, 0x1a, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x44, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f,
+	0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x46, 0x0a,
+	0x12, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x20, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x40, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x45, 0x78, 0x65, 0x63, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x78, 0x65, 0x63, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x78, 0x65, 0x63, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x28, 0x01, 0x30, 0x01, 0x12, 0x3e, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x28, 0x01, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x30, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x16, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x0c, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75,
+	0x6c, 0x6c, 0x12, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x50, 0x75, 0x6c, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x34, 0x0a, 0x0a, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x3a, 0x0a, 0x0d, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
+	0x0c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a,
+	0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x0b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x75,
+	0x6e, 0x65, 0x12, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0d, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x79, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2d, 0x69,
+	0x6f, 0x2f, 0x64, 0x61, 0x72, 0x6b, 0x6c, 0x65, 0x6e, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protobuf_proto_agent_proto_rawDescOnce sync.Once
+	file_protobuf_proto_agent_proto_rawDescData = file_protobuf_proto_agent_proto_rawDesc
+)
+
+func file_protobuf_proto_agent_proto_rawDescGZIP() []byte {
+	file_protobuf_proto_agent_proto_rawDescOnce.Do(func() {
+		file_protobuf_proto_agent_proto_rawDescData = protoimpl.X.CompressGZIP(file_protobuf_proto_agent_proto_rawDescData)
+	})
+	return file_protobuf_proto_agent_proto_rawDescData
+}
+
+var file_protobuf_proto_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_protobuf_proto_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
+var file_protobuf_proto_agent_proto_goTypes = []interface{}{
+	(CloseReason)(0),                  // 0: agent.CloseReason
+	(ContainerOperation)(0),           // 1: agent.ContainerOperation
+	(StackOperation)(0),               // 2: agent.StackOperation
+	(ErrorCode)(0),                    // 3: agent.ErrorCode
+	(ContainerState)(0),               // 4: agent.ContainerState
+	(ContainerStateEvent)(0),          // 5: agent.ContainerStateEvent
+	(ContainerHealth)(0),              // 6: agent.ContainerHealth
+	(LogFormat)(0),                    // 7: agent.LogFormat
+	(LogLevel)(0),                     // 8: agent.LogLevel
+	(ContainerStream)(0),              // 9: agent.ContainerStream
+	(*Empty)(nil),                     // 10: agent.Empty
+	(*AgentInfo)(nil),                 // 11: agent.AgentInfo
+	(*AgentCommand)(nil),              // 12: agent.AgentCommand
+	(*ContainerStateRequest)(nil),     // 13: agent.ContainerStateRequest
+	(*CloseConnectionRequest)(nil),    // 14: agent.CloseConnectionRequest
+	(*ContainerCommandRequest)(nil),   // 15: agent.ContainerCommandRequest
+	(*StackCommandRequest)(nil),       // 16: agent.StackCommandRequest
+	(*ContainerDeleteRequest)(nil),    // 17: agent.ContainerDeleteRequest
+	(*ContainerLogRequest)(nil),       // 18: agent.ContainerLogRequest
+	(*ContainerLogMultiline)(nil),     // 19: agent.ContainerLogMultiline
+	(*ContainerLogFilter)(nil),        // 20: agent.ContainerLogFilter
+	(*ContainerLogExportRequest)(nil), // 21: agent.ContainerLogExportRequest
+	(*ContainerInspectRequest)(nil),   // 22: agent.ContainerInspectRequest
+	(*ContainerExecRequest)(nil),      // 23: agent.ContainerExecRequest
+	(*ContainerStatsRequest)(nil),     // 24: agent.ContainerStatsRequest
+	(*ContainerEventsRequest)(nil),    // 25: agent.ContainerEventsRequest
+	(*ImageListRequest)(nil),          // 26: agent.ImageListRequest
+	(*ImageInspectRequest)(nil),       // 27: agent.ImageInspectRequest
+	(*ImageDeleteRequest)(nil),        // 28: agent.ImageDeleteRequest
+	(*ImagePullRequest)(nil),          // 29: agent.ImagePullRequest
+	(*VolumeListRequest)(nil),         // 30: agent.VolumeListRequest
+	(*VolumeInspectRequest)(nil),      // 31: agent.VolumeInspectRequest
+	(*VolumeDeleteRequest)(nil),       // 32: agent.VolumeDeleteRequest
+	(*NetworkListRequest)(nil),        // 33: agent.NetworkListRequest
+	(*NetworkInspectRequest)(nil),     // 34: agent.NetworkInspectRequest
+	(*NetworkDeleteRequest)(nil),      // 35: agent.NetworkDeleteRequest
+	(*SystemPruneRequest)(nil),        // 36: agent.SystemPruneRequest
+	(*CommandResultMessage)(nil),      // 37: agent.CommandResultMessage
+	(*ContainerStateItemPort)(nil),    // 38: agent.ContainerStateItemPort
+	(*ContainerStateItemNetwork)(nil), // 39: agent.ContainerStateItemNetwork
+	(*ContainerStateItemMount)(nil),   // 40: agent.ContainerStateItemMount
+	(*ContainerStateItem)(nil),        // 41: agent.ContainerStateItem
+	(*ContainerStateListMessage)(nil), // 42: agent.ContainerStateListMessage
+	(*ContainerLogMessage)(nil),       // 43: agent.ContainerLogMessage
+	(*StructuredLog)(nil),             // 44: agent.StructuredLog
+	(*ContainerLogBatchMessage)(nil),  // 45: agent.ContainerLogBatchMessage
+	(*ContainerLogExportSummary)(nil), // 46: agent.ContainerLogExportSummary
+	(*ContainerLogExportMessage)(nil), // 47: agent.ContainerLogExportMessage
+	(*ContainerInspectMessage)(nil),   // 48: agent.ContainerInspectMessage
+	(*ContainerExecResize)(nil),       // 49: agent.ContainerExecResize
+	(*ContainerExecOutput)(nil),       // 50: agent.ContainerExecOutput
+	(*ContainerExecExit)(nil),         // 51: agent.ContainerExecExit
+	(*ContainerExecMessage)(nil),      // 52: agent.ContainerExecMessage
+	(*ContainerExecInput)(nil),        // 53: agent.ContainerExecInput
+	(*ContainerStatsMessage)(nil),     // 54: agent.ContainerStatsMessage
+	(*ContainerEventMessage)(nil),     // 55: agent.ContainerEventMessage
+	(*NodeDiskUsage)(nil),             // 56: agent.NodeDiskUsage
+	(*NodeInfoMessage)(nil),           // 57: agent.NodeInfoMessage
+	(*ImageItem)(nil),                 // 58: agent.ImageItem
+	(*ImageListMessage)(nil),          // 59: agent.ImageListMessage
+	(*ImageInspectMessage)(nil),       // 60: agent.ImageInspectMessage
+	(*ImagePullMessage)(nil),          // 61: agent.ImagePullMessage
+	(*VolumeItem)(nil),                // 62: agent.VolumeItem
+	(*VolumeListMessage)(nil),         // 63: agent.VolumeListMessage
+	(*VolumeInspectMessage)(nil),      // 64: agent.VolumeInspectMessage
+	(*NetworkItem)(nil),               // 65: agent.NetworkItem
+	(*NetworkListMessage)(nil),        // 66: agent.NetworkListMessage
+	(*NetworkInspectMessage)(nil),     // 67: agent.NetworkInspectMessage
+	(*SystemPruneItem)(nil),           // 68: agent.SystemPruneItem
+	(*SystemPruneMessage)(nil),        // 69: agent.SystemPruneMessage
+	nil,                               // 70: agent.ContainerStateItem.LabelsEntry
+	nil,                               // 71: agent.StructuredLog.FieldsEntry
+	nil,                               // 72: agent.ContainerEventMessage.AttributesEntry
+	nil,                               // 73: agent.VolumeItem.LabelsEntry
+	nil,                               // 74: agent.NetworkItem.LabelsEntry
+	(*timestamppb.Timestamp)(nil),     // 75: google.protobuf.Timestamp
+}
+var file_protobuf_proto_agent_proto_depIdxs = []int32{
+	13,  // 0: agent.AgentCommand.containerState:type_name -> agent.ContainerStateRequest
+	14,  // 1: agent.AgentCommand.close:type_name -> agent.CloseConnectionRequest
+	15,  // 2: agent.AgentCommand.containerCommand:type_name -> agent.ContainerCommandRequest
+	17,  // 3: agent.AgentCommand.containerDelete:type_name -> agent.ContainerDeleteRequest
+	18,  // 4: agent.AgentCommand.containerLog:type_name -> agent.ContainerLogRequest
+	22,  // 5: agent.AgentCommand.containerInspect:type_name -> agent.ContainerInspectRequest
+	23,  // 6: agent.AgentCommand.containerExec:type_name -> agent.ContainerExecRequest
+	24,  // 7: agent.AgentCommand.containerStats:type_name -> agent.ContainerStatsRequest
+	26,  // 8: agent.AgentCommand.imageList:type_name -> agent.ImageListRequest
+	27,  // 9: agent.AgentCommand.imageInspect:type_name -> agent.ImageInspectRequest
+	28,  // 10: agent.AgentCommand.imageDelete:type_name -> agent.ImageDeleteRequest
+	29,  // 11: agent.AgentCommand.imagePull:type_name -> agent.ImagePullRequest
+	30,  // 12: agent.AgentCommand.volumeList:type_name -> agent.VolumeListRequest
+	31,  // 13: agent.AgentCommand.volumeInspect:type_name -> agent.VolumeInspectRequest
+	32,  // 14: agent.AgentCommand.volumeDelete:type_name -> agent.VolumeDeleteRequest
+	33,  // 15: agent.AgentCommand.networkList:type_name -> agent.NetworkListRequest
+	34,  // 16: agent.AgentCommand.networkInspect:type_name -> agent.NetworkInspectRequest
+	35,  // 17: agent.AgentCommand.networkDelete:type_name -> agent.NetworkDeleteRequest
+	36,  // 18: agent.AgentCommand.systemPrune:type_name -> agent.SystemPruneRequest
+	21,  // 19: agent.AgentCommand.containerLogExport:type_name -> agent.ContainerLogExportRequest
+	16,  // 20: agent.AgentCommand.stackCommand:type_name -> agent.StackCommandRequest
+	25,  // 21: agent.AgentCommand.containerEvents:type_name -> agent.ContainerEventsRequest
+	0,   // 22: agent.CloseConnectionRequest.reason:type_name -> agent.CloseReason
+	1,   // 23: agent.ContainerCommandRequest.operation:type_name -> agent.ContainerOperation
+	2,   // 24: agent.StackCommandRequest.operation:type_name -> agent.StackOperation
+	75,  // 25: agent.ContainerLogRequest.since:type_name -> google.protobuf.Timestamp
+	75,  // 26: agent.ContainerLogRequest.until:type_name -> google.protobuf.Timestamp
+	9,   // 27: agent.ContainerLogRequest.stream:type_name -> agent.ContainerStream
+	20,  // 28: agent.ContainerLogRequest.filter:type_name -> agent.ContainerLogFilter
+	19,  // 29: agent.ContainerLogRequest.multiline:type_name -> agent.ContainerLogMultiline
+	75,  // 30: agent.ContainerLogExportRequest.since:type_name -> google.protobuf.Timestamp
+	75,  // 31: agent.ContainerLogExportRequest.until:type_name -> google.protobuf.Timestamp
+	49,  // 32: agent.ContainerExecRequest.size:type_name -> agent.ContainerExecResize
+	75,  // 33: agent.ContainerEventsRequest.since:type_name -> google.protobuf.Timestamp
+	75,  // 34: agent.ContainerEventsRequest.until:type_name -> google.protobuf.Timestamp
+	3,   // 35: agent.CommandResultMessage.errorCode:type_name -> agent.ErrorCode
+	75,  // 36: agent.ContainerStateItem.createdAt:type_name -> google.protobuf.Timestamp
+	4,   // 37: agent.ContainerStateItem.state:type_name -> agent.ContainerState
+	38,  // 38: agent.ContainerStateItem.ports:type_name -> agent.ContainerStateItemPort
+	70,  // 39: agent.ContainerStateItem.labels:type_name -> agent.ContainerStateItem.LabelsEntry
+	6,   // 40: agent.ContainerStateItem.health:type_name -> agent.ContainerHealth
+	39,  // 41: agent.ContainerStateItem.networks:type_name -> agent.ContainerStateItemNetwork
+	40,  // 42: agent.ContainerStateItem.mounts:type_name -> agent.ContainerStateItemMount
+	5,   // 43: agent.ContainerStateItem.event:type_name -> agent.ContainerStateEvent
+	41,  // 44: agent.ContainerStateListMessage.data:type_name -> agent.ContainerStateItem
+	75,  // 45: agent.ContainerLogMessage.timestamp:type_name -> google.protobuf.Timestamp
+	9,   // 46: agent.ContainerLogMessage.stream:type_name -> agent.ContainerStream
+	44,  // 47: agent.ContainerLogMessage.structured:type_name -> agent.StructuredLog
+	7,   // 48: agent.StructuredLog.format:type_name -> agent.LogFormat
+	8,   // 49: agent.StructuredLog.level:type_name -> agent.LogLevel
+	75,  // 50: agent.StructuredLog.timestamp:type_name -> google.protobuf.Timestamp
+	71,  // 51: agent.StructuredLog.fields:type_name -> agent.StructuredLog.FieldsEntry
+	43,  // 52: agent.ContainerLogBatchMessage.logs:type_name -> agent.ContainerLogMessage
+	3,   // 53: agent.ContainerLogExportSummary.errorCode:type_name -> agent.ErrorCode
+	46,  // 54: agent.ContainerLogExportMessage.summary:type_name -> agent.ContainerLogExportSummary
+	3,   // 55: agent.ContainerInspectMessage.errorCode:type_name -> agent.ErrorCode
+	9,   // 56: agent.ContainerExecOutput.stream:type_name -> agent.ContainerStream
+	50,  // 57: agent.ContainerExecMessage.output:type_name -> agent.ContainerExecOutput
+	51,  // 58: agent.ContainerExecMessage.exit:type_name -> agent.ContainerExecExit
+	49,  // 59: agent.ContainerExecInput.resize:type_name -> agent.ContainerExecResize
+	75,  // 60: agent.ContainerStatsMessage.timestamp:type_name -> google.protobuf.Timestamp
+	75,  // 61: agent.ContainerEventMessage.timestamp:type_name -> google.protobuf.Timestamp
+	72,  // 62: agent.ContainerEventMessage.attributes:type_name -> agent.ContainerEventMessage.AttributesEntry
+	56,  // 63: agent.NodeInfoMessage.diskUsage:type_name -> agent.NodeDiskUsage
+	75,  // 64: agent.ImageItem.createdAt:type_name -> google.protobuf.Timestamp
+	58,  // 65: agent.ImageListMessage.data:type_name -> agent.ImageItem
+	3,   // 66: agent.ImageListMessage.errorCode:type_name -> agent.ErrorCode
+	3,   // 67: agent.ImageInspectMessage.errorCode:type_name -> agent.ErrorCode
+	3,   // 68: agent.ImagePullMessage.errorCode:type_name -> agent.ErrorCode
+	75,  // 69: agent.VolumeItem.createdAt:type_name -> google.protobuf.Timestamp
+	73,  // 70: agent.VolumeItem.labels:type_name -> agent.VolumeItem.LabelsEntry
+	62,  // 71: agent.VolumeListMessage.data:type_name -> agent.VolumeItem
+	3,   // 72: agent.VolumeListMessage.errorCode:type_name -> agent.ErrorCode
+	3,   // 73: agent.VolumeInspectMessage.errorCode:type_name -> agent.ErrorCode
+	75,  // 74: agent.NetworkItem.createdAt:type_name -> google.protobuf.Timestamp
+	74,  // 75: agent.NetworkItem.labels:type_name -> agent.NetworkItem.LabelsEntry
+	65,  // 76: agent.NetworkListMessage.data:type_name -> agent.NetworkItem
+	3,   // 77: agent.NetworkListMessage.errorCode:type_name -> agent.ErrorCode
+	3,   // 78: agent.NetworkInspectMessage.errorCode:type_name -> agent.ErrorCode
+	68,  // 79: agent.SystemPruneMessage.containers:type_name -> agent.SystemPruneItem
+	68,  // 80: agent.SystemPruneMessage.images:type_name -> agent.SystemPruneItem
+	68,  // 81: agent.SystemPruneMessage.volumes:type_name -> agent.SystemPruneItem
+	68,  // 82: agent.SystemPruneMessage.networks:type_name -> agent.SystemPruneItem
+	68,  // 83: agent.SystemPruneMessage.buildCache:type_name -> agent.SystemPruneItem
+	3,   // 84: agent.SystemPruneMessage.errorCode:type_name -> agent.ErrorCode
+	11,  // 85: agent.Agent.Connect:input_type -> agent.AgentInfo
+	42,  // 86: agent.Agent.ContainerState:input_type -> agent.ContainerStateListMessage
+	17,  // 87: agent.Agent.DeleteContainer:input_type -> agent.ContainerDeleteRequest
+	43,  // 88: agent.Agent.ContainerLog:input_type -> agent.ContainerLogMessage
+	45,  // 89: agent.Agent.ContainerLogBatch:input_type -> agent.ContainerLogBatchMessage
+	47,  // 90: agent.Agent.ContainerLogExport:input_type -> agent.ContainerLogExportMessage
+	48,  // 91: agent.Agent.ContainerInspect:input_type -> agent.ContainerInspectMessage
+	52,  // 92: agent.Agent.ContainerExec:input_type -> agent.ContainerExecMessage
+	54,  // 93: agent.Agent.ContainerStats:input_type -> agent.ContainerStatsMessage
+	55,  // 94: agent.Agent.ContainerEvents:input_type -> agent.ContainerEventMessage
+	57,  // 95: agent.Agent.NodeInfo:input_type -> agent.NodeInfoMessage
+	59,  // 96: agent.Agent.ImageList:input_type -> agent.ImageListMessage
+	60,  // 97: agent.Agent.ImageInspect:input_type -> agent.ImageInspectMessage
+	61,  // 98: agent.Agent.ImagePull:input_type -> agent.ImagePullMessage
+	63,  // 99: agent.Agent.VolumeList:input_type -> agent.VolumeListMessage
+	64,  // 100: agent.Agent.VolumeInspect:input_type -> agent.VolumeInspectMessage
+	66,  // 101: agent.Agent.NetworkList:input_type -> agent.NetworkListMessage
+	67,  // 102: agent.Agent.NetworkInspect:input_type -> agent.NetworkInspectMessage
+	69,  // 103: agent.Agent.SystemPrune:input_type -> agent.SystemPruneMessage
+	37,  // 104: agent.Agent.CommandResult:input_type -> agent.CommandResultMessage
+	12,  // 105: agent.Agent.Connect:output_type -> agent.AgentCommand
+	10,  // 106: agent.Agent.ContainerState:output_type -> agent.Empty
+	10,  // 107: agent.Agent.DeleteContainer:output_type -> agent.Empty
+	10,  // 108: agent.Agent.ContainerLog:output_type -> agent.Empty
+	10,  // 109: agent.Agent.ContainerLogBatch:output_type -> agent.Empty
+	10,  // 110: agent.Agent.ContainerLogExport:output_type -> agent.Empty
+	10,  // 111: agent.Agent.ContainerInspect:output_type -> agent.Empty
+	53,  // 112: agent.Agent.ContainerExec:output_type -> agent.ContainerExecInput
+	10,  // 113: agent.Agent.ContainerStats:output_type -> agent.Empty
+	10,  // 114: agent.Agent.ContainerEvents:output_type -> agent.Empty
+	10,  // 115: agent.Agent.NodeInfo:output_type -> agent.Empty
+	10,  // 116: agent.Agent.ImageList:output_type -> agent.Empty
+	10,  // 117: agent.Agent.ImageInspect:output_type -> agent.Empty
+	10,  // 118: agent.Agent.ImagePull:output_type -> agent.Empty
+	10,  // 119: agent.Agent.VolumeList:output_type -> agent.Empty
+	10,  // 120: agent.Agent.VolumeInspect:output_type -> agent.Empty
+	10,  // 121: agent.Agent.NetworkList:output_type -> agent.Empty
+	10,  // 122: agent.Agent.NetworkInspect:output_type -> agent.Empty
+	10,  // 123: agent.Agent.SystemPrune:output_type -> agent.Empty
+	10,  // 124: agent.Agent.CommandResult:output_type -> agent.Empty
+	105, // [105:125] is the sub-list for method output_type
+	85,  // [85:105] is the sub-list for method input_type
+	85,  // [85:85] is the sub-list for extension type_name
+	85,  // [85:85] is the sub-list for extension extendee
+	0,   // [0:85] is the sub-list for field type_name
+}
+
+func init() { file_protobuf_proto_agent_proto_init() }
+func file_protobuf_proto_agent_proto_init() {
+	if File_protobuf_proto_agent_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protobuf_proto_agent_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseConnectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerCommandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StackCommandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerLogMultiline); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerLogFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerLogExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerInspectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerExecRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageInspectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImagePullRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeInspectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkInspectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1264,8 +6748,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AgentInfo); i {
+		file_protobuf_proto_agent_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1276,8 +6760,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AgentCommand); i {
+		file_protobuf_proto_agent_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemPruneRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1288,8 +6772,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerStateRequest); i {
+		file_protobuf_proto_agent_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandResultMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1300,8 +6784,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseConnectionRequest); i {
+		file_protobuf_proto_agent_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerStateItemPort); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1312,8 +6796,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerCommandRequest); i {
+		file_protobuf_proto_agent_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerStateItemNetwork); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1324,8 +6808,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerDeleteRequest); i {
+		file_protobuf_proto_agent_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerStateItemMount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1336,8 +6820,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerLogRequest); i {
+		file_protobuf_proto_agent_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerStateItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1348,8 +6832,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerInspectRequest); i {
+		file_protobuf_proto_agent_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerStateListMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1360,8 +6844,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerStateItemPort); i {
+		file_protobuf_proto_agent_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerLogMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1372,8 +6856,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerStateItem); i {
+		file_protobuf_proto_agent_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StructuredLog); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1384,8 +6868,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerStateListMessage); i {
+		file_protobuf_proto_agent_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerLogBatchMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1396,8 +6880,8 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerLogMessage); i {
+		file_protobuf_proto_agent_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerLogExportSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1408,7 +6892,19 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_proto_agent_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_proto_agent_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerLogExportMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ContainerInspectMessage); i {
 			case 0:
 				return &v.state
@@ -1420,6 +6916,258 @@ func file_protobuf_proto_agent_proto_init() {
 				return nil
 			}
 		}
+		file_protobuf_proto_agent_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerExecResize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerExecOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerExecExit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerExecMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerExecInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerStatsMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerEventMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeDiskUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeInfoMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageListMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageInspectMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImagePullMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeListMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeInspectMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkListMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkInspectMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemPruneItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_proto_agent_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemPruneMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_protobuf_proto_agent_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*AgentCommand_ContainerState)(nil),
@@ -1428,15 +7176,54 @@ func file_protobuf_proto_agent_proto_init() {
 		(*AgentCommand_ContainerDelete)(nil),
 		(*AgentCommand_ContainerLog)(nil),
 		(*AgentCommand_ContainerInspect)(nil),
+		(*AgentCommand_ContainerExec)(nil),
+		(*AgentCommand_ContainerStats)(nil),
+		(*AgentCommand_ImageList)(nil),
+		(*AgentCommand_ImageInspect)(nil),
+		(*AgentCommand_ImageDelete)(nil),
+		(*AgentCommand_ImagePull)(nil),
+		(*AgentCommand_VolumeList)(nil),
+		(*AgentCommand_VolumeInspect)(nil),
+		(*AgentCommand_VolumeDelete)(nil),
+		(*AgentCommand_NetworkList)(nil),
+		(*AgentCommand_NetworkInspect)(nil),
+		(*AgentCommand_NetworkDelete)(nil),
+		(*AgentCommand_SystemPrune)(nil),
+		(*AgentCommand_ContainerLogExport)(nil),
+		(*AgentCommand_StackCommand)(nil),
+		(*AgentCommand_ContainerEvents)(nil),
 	}
 	file_protobuf_proto_agent_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[33].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[34].OneofWrappers = []interface{}{}
+	file_protobuf_proto_agent_proto_msgTypes[37].OneofWrappers = []interface{}{
+		(*ContainerLogExportMessage_Chunk)(nil),
+		(*ContainerLogExportMessage_Summary)(nil),
+	}
+	file_protobuf_proto_agent_proto_msgTypes[42].OneofWrappers = []interface{}{
+		(*ContainerExecMessage_Output)(nil),
+		(*ContainerExecMessage_Exit)(nil),
+	}
+	file_protobuf_proto_agent_proto_msgTypes[43].OneofWrappers = []interface{}{
+		(*ContainerExecInput_Stdin)(nil),
+		(*ContainerExecInput_Resize)(nil),
+		(*ContainerExecInput_CloseStdin)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protobuf_proto_agent_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   14,
+			NumEnums:      10,
+			NumMessages:   65,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
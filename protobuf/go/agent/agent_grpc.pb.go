@@ -26,7 +26,22 @@ type AgentClient interface {
 	ContainerState(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerStateClient, error)
 	DeleteContainer(ctx context.Context, in *ContainerDeleteRequest, opts ...grpc.CallOption) (*Empty, error)
 	ContainerLog(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerLogClient, error)
+	ContainerLogBatch(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerLogBatchClient, error)
+	ContainerLogExport(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerLogExportClient, error)
 	ContainerInspect(ctx context.Context, in *ContainerInspectMessage, opts ...grpc.CallOption) (*Empty, error)
+	ContainerExec(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerExecClient, error)
+	ContainerStats(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerStatsClient, error)
+	ContainerEvents(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerEventsClient, error)
+	NodeInfo(ctx context.Context, in *NodeInfoMessage, opts ...grpc.CallOption) (*Empty, error)
+	ImageList(ctx context.Context, in *ImageListMessage, opts ...grpc.CallOption) (*Empty, error)
+	ImageInspect(ctx context.Context, in *ImageInspectMessage, opts ...grpc.CallOption) (*Empty, error)
+	ImagePull(ctx context.Context, opts ...grpc.CallOption) (Agent_ImagePullClient, error)
+	VolumeList(ctx context.Context, in *VolumeListMessage, opts ...grpc.CallOption) (*Empty, error)
+	VolumeInspect(ctx context.Context, in *VolumeInspectMessage, opts ...grpc.CallOption) (*Empty, error)
+	NetworkList(ctx context.Context, in *NetworkListMessage, opts ...grpc.CallOption) (*Empty, error)
+	NetworkInspect(ctx context.Context, in *NetworkInspectMessage, opts ...grpc.CallOption) (*Empty, error)
+	SystemPrune(ctx context.Context, in *SystemPruneMessage, opts ...grpc.CallOption) (*Empty, error)
+	CommandResult(ctx context.Context, in *CommandResultMessage, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type agentClient struct {
@@ -146,6 +161,74 @@ func (x *agentContainerLogClient) CloseAndRecv() (*Empty, error) {
 	return m, nil
 }
 
+func (c *agentClient) ContainerLogBatch(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerLogBatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[3], "/agent.Agent/ContainerLogBatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentContainerLogBatchClient{stream}
+	return x, nil
+}
+
+type Agent_ContainerLogBatchClient interface {
+	Send(*ContainerLogBatchMessage) error
+	CloseAndRecv() (*Empty, error)
+	grpc.ClientStream
+}
+
+type agentContainerLogBatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentContainerLogBatchClient) Send(m *ContainerLogBatchMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentContainerLogBatchClient) CloseAndRecv() (*Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentClient) ContainerLogExport(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerLogExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[4], "/agent.Agent/ContainerLogExport", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentContainerLogExportClient{stream}
+	return x, nil
+}
+
+type Agent_ContainerLogExportClient interface {
+	Send(*ContainerLogExportMessage) error
+	CloseAndRecv() (*Empty, error)
+	grpc.ClientStream
+}
+
+type agentContainerLogExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentContainerLogExportClient) Send(m *ContainerLogExportMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentContainerLogExportClient) CloseAndRecv() (*Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *agentClient) ContainerInspect(ctx context.Context, in *ContainerInspectMessage, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/agent.Agent/ContainerInspect", in, out, opts...)
@@ -155,6 +238,220 @@ func (c *agentClient) ContainerInspect(ctx context.Context, in *ContainerInspect
 	return out, nil
 }
 
+func (c *agentClient) ContainerExec(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerExecClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[5], "/agent.Agent/ContainerExec", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentContainerExecClient{stream}
+	return x, nil
+}
+
+type Agent_ContainerExecClient interface {
+	Send(*ContainerExecMessage) error
+	Recv() (*ContainerExecInput, error)
+	grpc.ClientStream
+}
+
+type agentContainerExecClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentContainerExecClient) Send(m *ContainerExecMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentContainerExecClient) Recv() (*ContainerExecInput, error) {
+	m := new(ContainerExecInput)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentClient) ContainerStats(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerStatsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[6], "/agent.Agent/ContainerStats", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentContainerStatsClient{stream}
+	return x, nil
+}
+
+type Agent_ContainerStatsClient interface {
+	Send(*ContainerStatsMessage) error
+	CloseAndRecv() (*Empty, error)
+	grpc.ClientStream
+}
+
+type agentContainerStatsClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentContainerStatsClient) Send(m *ContainerStatsMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentContainerStatsClient) CloseAndRecv() (*Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentClient) ContainerEvents(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[7], "/agent.Agent/ContainerEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentContainerEventsClient{stream}
+	return x, nil
+}
+
+type Agent_ContainerEventsClient interface {
+	Send(*ContainerEventMessage) error
+	CloseAndRecv() (*Empty, error)
+	grpc.ClientStream
+}
+
+type agentContainerEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentContainerEventsClient) Send(m *ContainerEventMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentContainerEventsClient) CloseAndRecv() (*Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentClient) NodeInfo(ctx context.Context, in *NodeInfoMessage, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/NodeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) ImageList(ctx context.Context, in *ImageListMessage, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/ImageList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) ImageInspect(ctx context.Context, in *ImageInspectMessage, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/ImageInspect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) ImagePull(ctx context.Context, opts ...grpc.CallOption) (Agent_ImagePullClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[8], "/agent.Agent/ImagePull", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentImagePullClient{stream}
+	return x, nil
+}
+
+type Agent_ImagePullClient interface {
+	Send(*ImagePullMessage) error
+	CloseAndRecv() (*Empty, error)
+	grpc.ClientStream
+}
+
+type agentImagePullClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentImagePullClient) Send(m *ImagePullMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentImagePullClient) CloseAndRecv() (*Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentClient) VolumeList(ctx context.Context, in *VolumeListMessage, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/VolumeList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) VolumeInspect(ctx context.Context, in *VolumeInspectMessage, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/VolumeInspect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) NetworkList(ctx context.Context, in *NetworkListMessage, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/NetworkList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) NetworkInspect(ctx context.Context, in *NetworkInspectMessage, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/NetworkInspect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) SystemPrune(ctx context.Context, in *SystemPruneMessage, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/SystemPrune", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) CommandResult(ctx context.Context, in *CommandResultMessage, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/CommandResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentServer is the server API for Agent service.
 // All implementations must embed UnimplementedAgentServer
 // for forward compatibility
@@ -163,7 +460,22 @@ type AgentServer interface {
 	ContainerState(Agent_ContainerStateServer) error
 	DeleteContainer(context.Context, *ContainerDeleteRequest) (*Empty, error)
 	ContainerLog(Agent_ContainerLogServer) error
+	ContainerLogBatch(Agent_ContainerLogBatchServer) error
+	ContainerLogExport(Agent_ContainerLogExportServer) error
 	ContainerInspect(context.Context, *ContainerInspectMessage) (*Empty, error)
+	ContainerExec(Agent_ContainerExecServer) error
+	ContainerStats(Agent_ContainerStatsServer) error
+	ContainerEvents(Agent_ContainerEventsServer) error
+	NodeInfo(context.Context, *NodeInfoMessage) (*Empty, error)
+	ImageList(context.Context, *ImageListMessage) (*Empty, error)
+	ImageInspect(context.Context, *ImageInspectMessage) (*Empty, error)
+	ImagePull(Agent_ImagePullServer) error
+	VolumeList(context.Context, *VolumeListMessage) (*Empty, error)
+	VolumeInspect(context.Context, *VolumeInspectMessage) (*Empty, error)
+	NetworkList(context.Context, *NetworkListMessage) (*Empty, error)
+	NetworkInspect(context.Context, *NetworkInspectMessage) (*Empty, error)
+	SystemPrune(context.Context, *SystemPruneMessage) (*Empty, error)
+	CommandResult(context.Context, *CommandResultMessage) (*Empty, error)
 	mustEmbedUnimplementedAgentServer()
 }
 
@@ -183,9 +495,54 @@ func (UnimplementedAgentServer) DeleteContainer(context.Context, *ContainerDelet
 func (UnimplementedAgentServer) ContainerLog(Agent_ContainerLogServer) error {
 	return status.Errorf(codes.Unimplemented, "method ContainerLog not implemented")
 }
+func (UnimplementedAgentServer) ContainerLogBatch(Agent_ContainerLogBatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method ContainerLogBatch not implemented")
+}
+func (UnimplementedAgentServer) ContainerLogExport(Agent_ContainerLogExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method ContainerLogExport not implemented")
+}
 func (UnimplementedAgentServer) ContainerInspect(context.Context, *ContainerInspectMessage) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ContainerInspect not implemented")
 }
+func (UnimplementedAgentServer) ContainerExec(Agent_ContainerExecServer) error {
+	return status.Errorf(codes.Unimplemented, "method ContainerExec not implemented")
+}
+func (UnimplementedAgentServer) ContainerStats(Agent_ContainerStatsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ContainerStats not implemented")
+}
+func (UnimplementedAgentServer) ContainerEvents(Agent_ContainerEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ContainerEvents not implemented")
+}
+func (UnimplementedAgentServer) NodeInfo(context.Context, *NodeInfoMessage) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeInfo not implemented")
+}
+func (UnimplementedAgentServer) ImageList(context.Context, *ImageListMessage) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImageList not implemented")
+}
+func (UnimplementedAgentServer) ImageInspect(context.Context, *ImageInspectMessage) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImageInspect not implemented")
+}
+func (UnimplementedAgentServer) ImagePull(Agent_ImagePullServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImagePull not implemented")
+}
+func (UnimplementedAgentServer) VolumeList(context.Context, *VolumeListMessage) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeList not implemented")
+}
+func (UnimplementedAgentServer) VolumeInspect(context.Context, *VolumeInspectMessage) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeInspect not implemented")
+}
+func (UnimplementedAgentServer) NetworkList(context.Context, *NetworkListMessage) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetworkList not implemented")
+}
+func (UnimplementedAgentServer) NetworkInspect(context.Context, *NetworkInspectMessage) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetworkInspect not implemented")
+}
+func (UnimplementedAgentServer) SystemPrune(context.Context, *SystemPruneMessage) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemPrune not implemented")
+}
+func (UnimplementedAgentServer) CommandResult(context.Context, *CommandResultMessage) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommandResult not implemented")
+}
 func (UnimplementedAgentServer) mustEmbedUnimplementedAgentServer() {}
 
 // UnsafeAgentServer may be embedded to opt out of forward compatibility for this service.
@@ -290,6 +647,58 @@ func (x *agentContainerLogServer) Recv() (*ContainerLogMessage, error) {
 	return m, nil
 }
 
+func _Agent_ContainerLogBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).ContainerLogBatch(&agentContainerLogBatchServer{stream})
+}
+
+type Agent_ContainerLogBatchServer interface {
+	SendAndClose(*Empty) error
+	Recv() (*ContainerLogBatchMessage, error)
+	grpc.ServerStream
+}
+
+type agentContainerLogBatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentContainerLogBatchServer) SendAndClose(m *Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentContainerLogBatchServer) Recv() (*ContainerLogBatchMessage, error) {
+	m := new(ContainerLogBatchMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Agent_ContainerLogExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).ContainerLogExport(&agentContainerLogExportServer{stream})
+}
+
+type Agent_ContainerLogExportServer interface {
+	SendAndClose(*Empty) error
+	Recv() (*ContainerLogExportMessage, error)
+	grpc.ServerStream
+}
+
+type agentContainerLogExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentContainerLogExportServer) SendAndClose(m *Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentContainerLogExportServer) Recv() (*ContainerLogExportMessage, error) {
+	m := new(ContainerLogExportMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Agent_ContainerInspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ContainerInspectMessage)
 	if err := dec(in); err != nil {
@@ -308,6 +717,272 @@ func _Agent_ContainerInspect_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Agent_ContainerExec_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).ContainerExec(&agentContainerExecServer{stream})
+}
+
+type Agent_ContainerExecServer interface {
+	Send(*ContainerExecInput) error
+	Recv() (*ContainerExecMessage, error)
+	grpc.ServerStream
+}
+
+type agentContainerExecServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentContainerExecServer) Send(m *ContainerExecInput) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentContainerExecServer) Recv() (*ContainerExecMessage, error) {
+	m := new(ContainerExecMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Agent_ContainerStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).ContainerStats(&agentContainerStatsServer{stream})
+}
+
+type Agent_ContainerStatsServer interface {
+	SendAndClose(*Empty) error
+	Recv() (*ContainerStatsMessage, error)
+	grpc.ServerStream
+}
+
+type agentContainerStatsServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentContainerStatsServer) SendAndClose(m *Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentContainerStatsServer) Recv() (*ContainerStatsMessage, error) {
+	m := new(ContainerStatsMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Agent_ContainerEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).ContainerEvents(&agentContainerEventsServer{stream})
+}
+
+type Agent_ContainerEventsServer interface {
+	SendAndClose(*Empty) error
+	Recv() (*ContainerEventMessage, error)
+	grpc.ServerStream
+}
+
+type agentContainerEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentContainerEventsServer) SendAndClose(m *Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentContainerEventsServer) Recv() (*ContainerEventMessage, error) {
+	m := new(ContainerEventMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Agent_NodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeInfoMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).NodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/NodeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).NodeInfo(ctx, req.(*NodeInfoMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_ImageList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageListMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ImageList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/ImageList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ImageList(ctx, req.(*ImageListMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_ImageInspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageInspectMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ImageInspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/ImageInspect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ImageInspect(ctx, req.(*ImageInspectMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_ImagePull_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).ImagePull(&agentImagePullServer{stream})
+}
+
+type Agent_ImagePullServer interface {
+	SendAndClose(*Empty) error
+	Recv() (*ImagePullMessage, error)
+	grpc.ServerStream
+}
+
+type agentImagePullServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentImagePullServer) SendAndClose(m *Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentImagePullServer) Recv() (*ImagePullMessage, error) {
+	m := new(ImagePullMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Agent_VolumeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeListMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).VolumeList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/VolumeList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).VolumeList(ctx, req.(*VolumeListMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_VolumeInspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeInspectMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).VolumeInspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/VolumeInspect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).VolumeInspect(ctx, req.(*VolumeInspectMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_NetworkList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkListMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).NetworkList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/NetworkList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).NetworkList(ctx, req.(*NetworkListMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_NetworkInspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkInspectMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).NetworkInspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/NetworkInspect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).NetworkInspect(ctx, req.(*NetworkInspectMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_SystemPrune_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemPruneMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).SystemPrune(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/SystemPrune",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).SystemPrune(ctx, req.(*SystemPruneMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_CommandResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommandResultMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).CommandResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/CommandResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).CommandResult(ctx, req.(*CommandResultMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Agent_ServiceDesc is the grpc.ServiceDesc for Agent service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -323,6 +998,42 @@ func _Agent_ContainerInspect_Handler(srv interface{}, ctx context.Context, dec f
 			MethodName: "ContainerInspect",
 			Handler:    _Agent_ContainerInspect_Handler,
 		},
+		{
+			MethodName: "NodeInfo",
+			Handler:    _Agent_NodeInfo_Handler,
+		},
+		{
+			MethodName: "ImageList",
+			Handler:    _Agent_ImageList_Handler,
+		},
+		{
+			MethodName: "ImageInspect",
+			Handler:    _Agent_ImageInspect_Handler,
+		},
+		{
+			MethodName: "VolumeList",
+			Handler:    _Agent_VolumeList_Handler,
+		},
+		{
+			MethodName: "VolumeInspect",
+			Handler:    _Agent_VolumeInspect_Handler,
+		},
+		{
+			MethodName: "NetworkList",
+			Handler:    _Agent_NetworkList_Handler,
+		},
+		{
+			MethodName: "NetworkInspect",
+			Handler:    _Agent_NetworkInspect_Handler,
+		},
+		{
+			MethodName: "SystemPrune",
+			Handler:    _Agent_SystemPrune_Handler,
+		},
+		{
+			MethodName: "CommandResult",
+			Handler:    _Agent_CommandResult_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -340,6 +1051,37 @@ func _Agent_ContainerInspect_Handler(srv interface{}, ctx context.Context, dec f
 			Handler:       _Agent_ContainerLog_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ContainerLogBatch",
+			Handler:       _Agent_ContainerLogBatch_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ContainerLogExport",
+			Handler:       _Agent_ContainerLogExport_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ContainerExec",
+			Handler:       _Agent_ContainerExec_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ContainerStats",
+			Handler:       _Agent_ContainerStats_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ContainerEvents",
+			Handler:       _Agent_ContainerEvents_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ImagePull",
+			Handler:       _Agent_ImagePull_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "protobuf/proto/agent.proto",
 }